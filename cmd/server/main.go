@@ -1,7 +1,10 @@
 package main
 
 import (
+	"context"
 	"log"
+	"strconv"
+	"time"
 
 	"bugforge-backend/internal/config"
 	"bugforge-backend/internal/database"
@@ -46,6 +49,14 @@ func main() {
 	kanbanRepo := kanbanrepo.NewKanbanRepo(db)
 	labelRepo := pg.NewLabelRepository(db)
 	notificationRepo := pg.NewNotificationRepoPG(db)
+	searchRepo := pg.NewSearchRepository(db)
+	issueTemplateRepo := pg.NewIssueTemplateRepository(db)
+	checklistTemplateRepo := pg.NewChecklistTemplateRepository(db)
+	sprintRepo := pg.NewSprintRepository(db)
+	releaseRepo := pg.NewReleaseRepository(db)
+	worklogRepo := pg.NewWorklogRepository(db)
+	analyticsRepo := pg.NewAnalyticsRepository(db)
+	portfolioRepo := pg.NewPortfolioRepository(db)
 
 	// -----------------------
 	// Services
@@ -60,16 +71,39 @@ func main() {
 	notificationService := service.NewNotificationService(notificationRepo, userRepo, notifHub)
 
 	issueService := service.NewIssueService(
-		issueRepo, projectRepo, userRepo, commentRepo, activityRepo, activityService, commentHub, notificationService,
+		issueRepo, projectRepo, projectMemberRepo, issueTemplateRepo, userRepo, commentRepo, activityRepo, activityService, commentHub, notificationService, hub,
 	)
 
 	projectMemberService := service.NewProjectMemberService(projectRepo, userRepo, projectMemberRepo)
-	kanbanService := service.NewKanbanService(issueRepo, projectRepo, projectMemberRepo, kanbanRepo)
+	kanbanService := service.NewKanbanService(issueRepo, projectRepo, projectMemberRepo, kanbanRepo, issueTemplateRepo, sprintRepo, activityService)
 	labelService := service.NewLabelService(labelRepo, projectRepo)
+	searchService := service.NewSearchService(searchRepo, projectRepo)
+	issueTemplateService := service.NewIssueTemplateService(issueTemplateRepo, projectRepo, userRepo, labelRepo)
+	checklistTemplateService := service.NewChecklistTemplateService(checklistTemplateRepo, issueRepo, projectRepo, activityService, hub)
+	issueBulkService := service.NewIssueBulkService(
+		issueRepo, projectRepo, userRepo, labelRepo, activityService, notificationService, hub,
+	)
+	sprintService := service.NewSprintService(sprintRepo, issueRepo, projectRepo, activityService, hub)
+	releaseService := service.NewReleaseService(releaseRepo, issueRepo, projectRepo, activityService)
+	worklogService := service.NewWorklogService(worklogRepo, issueRepo, projectRepo, userRepo, activityService)
+	analyticsService := service.NewAnalyticsService(analyticsRepo, sprintRepo, projectRepo)
+	portfolioService := service.NewPortfolioService(portfolioRepo, projectRepo, projectMemberRepo, kanbanRepo, kanbanService, hub)
 	
 
 	handlers.RegisterNotificationHandlers(notificationService)
 
+	// Trash retention (TRASH_RETENTION_DAYS, default 30)
+	retention := service.DefaultTrashRetention
+	if days, err := strconv.Atoi(cfg.TrashRetentionDays); err == nil && days > 0 {
+		retention = time.Duration(days) * 24 * time.Hour
+	}
+	trashPurger := service.NewTrashPurger(issueRepo, projectRepo, retention)
+	go trashPurger.Run(context.Background())
+
+	// Respace kanban ranks that grew too long
+	rankRebalancer := service.NewRankRebalancer(kanbanRepo)
+	go rankRebalancer.Run(context.Background())
+
 	// -----------------------
 	// Controllers
 	// -----------------------
@@ -83,11 +117,20 @@ func main() {
 	issueAttachmentController := controllers.NewIssueAttachmentController(issueService)
 	issueChecklistController := controllers.NewIssueChecklistController(issueService)
 	issueSubtaskController := controllers.NewIssueSubtaskController(issueService)
+	issueBulkController := controllers.NewIssueBulkController(issueBulkService)
+	issueTemplateController := controllers.NewIssueTemplateController(issueTemplateService)
+	checklistTemplateController := controllers.NewChecklistTemplateController(checklistTemplateService)
+	sprintController := controllers.NewSprintController(sprintService)
+	releaseController := controllers.NewReleaseController(releaseService)
+	worklogController := controllers.NewWorklogController(worklogService)
+	analyticsController := controllers.NewAnalyticsController(analyticsService)
+	portfolioController := controllers.NewPortfolioController(portfolioService)
 
 	projectMemberController := controllers.NewProjectMemberController(projectMemberService)
 	projectLabelController := controllers.NewProjectLabelController(labelService)
 
 	notificationController := controllers.NewNotificationController(notificationService)
+	searchController := controllers.NewSearchController(searchService)
 
 
 	// -----------------------
@@ -100,7 +143,8 @@ func main() {
 	api := app.Group("/api", cors.New(cors.Config{
 		AllowOrigins:     "http://localhost:5173",
 		AllowCredentials: true,
-		AllowHeaders:     "Origin, Content-Type, Accept, Authorization",
+		AllowHeaders:     "Origin, Content-Type, Accept, Authorization, If-Match",
+		ExposeHeaders:    "ETag",
 		AllowMethods:     "GET,POST,PUT,DELETE,OPTIONS,PATCH",
 	}))
 
@@ -130,6 +174,15 @@ func main() {
 		commentHub,
 	)
 
+	routes.IssueBulkRoutes(protected, issueBulkController)
+	routes.IssueTemplateRoutes(protected, issueTemplateController)
+	routes.ChecklistTemplateRoutes(protected, checklistTemplateController)
+	routes.SprintRoutes(protected, sprintController)
+	routes.ReleaseRoutes(protected, releaseController)
+	routes.WorklogRoutes(protected, worklogController)
+	routes.AnalyticsRoutes(protected, analyticsController)
+	routes.PortfolioRoutes(protected, portfolioController)
+
 	routes.UserRoutes(protected, userController)
 
 	// Kanban WS
@@ -137,9 +190,11 @@ func main() {
 
 	routes.NotificationRoutes(protected, notificationController)
 
+	routes.SearchRoutes(protected, searchController)
+
 	// Global WS routes
 	wsGroup := app.Group("/ws")
-	routes.RegisterWebSocketRoutes(wsGroup, hub)
+	routes.RegisterWebSocketRoutes(wsGroup, hub, kanbanService, portfolioService)
 	routes.RegisterIssueCommentWS(wsGroup, commentHub)
 	routes.RegisterNotificationWSRoutes(wsGroup, notifHub)
 
@@ -16,6 +16,7 @@ type Config struct {
 	DB_PORT string
 	JWTSecret string
     JWTExpiry string
+	TrashRetentionDays string
 }
 
 func Load() *Config {
@@ -30,5 +31,6 @@ func Load() *Config {
 		DB_PORT: os.Getenv("DB_PORT"),
 		JWTSecret: os.Getenv("JWT_SECRET"),
     	JWTExpiry: os.Getenv("JWT_EXPIRY"),
+		TrashRetentionDays: os.Getenv("TRASH_RETENTION_DAYS"),
 	}
 }
\ No newline at end of file
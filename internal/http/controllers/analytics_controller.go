@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"bugforge-backend/internal/http/controllers/interfaces"
+	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
+	service "bugforge-backend/internal/service/interfaces"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AnalyticsControllerImpl struct {
+	svc service.AnalyticsService
+}
+
+func NewAnalyticsController(s service.AnalyticsService) interfaces.AnalyticsController {
+	return &AnalyticsControllerImpl{svc: s}
+}
+
+// csvReport is implemented by every analytics model.
+type csvReport interface {
+	CSV() [][]string
+}
+
+// @Summary Sprint burndown and burnup
+// @Tags Analytics
+// @Param project_id path string true "Project ID"
+// @Param sprint_id query string true "Sprint ID"
+// @Param unit query string false "points or issues"
+// @Param format query string false "json (default) or csv"
+// @Success 200 {object} models.SprintBurndown
+// @Router /projects/{project_id}/analytics/burndown [get]
+func (ac *AnalyticsControllerImpl) Burndown(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+	if c.Query("sprint_id") == "" {
+		return helpers.Error(c, fiber.StatusBadRequest, "sprint_id is required")
+	}
+
+	out, err := ac.svc.GetBurndown(context.Background(), customerID.(string), c.Params("project_id"), c.Query("sprint_id"), c.Query("unit"))
+	if err != nil {
+		return analyticsError(c, err)
+	}
+	return sendReport(c, "burndown", out)
+}
+
+// @Summary Velocity over the last closed sprints
+// @Tags Analytics
+// @Param project_id path string true "Project ID"
+// @Param sprints query int false "Number of sprints (default 6)"
+// @Param format query string false "json (default) or csv"
+// @Success 200 {object} models.Velocity
+// @Router /projects/{project_id}/analytics/velocity [get]
+func (ac *AnalyticsControllerImpl) Velocity(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ac.svc.GetVelocity(context.Background(), customerID.(string), c.Params("project_id"), c.QueryInt("sprints"))
+	if err != nil {
+		return analyticsError(c, err)
+	}
+	return sendReport(c, "velocity", out)
+}
+
+// @Summary Cumulative flow per kanban column
+// @Tags Analytics
+// @Param project_id path string true "Project ID"
+// @Param from query string false "YYYY-MM-DD"
+// @Param to query string false "YYYY-MM-DD"
+// @Param format query string false "json (default) or csv"
+// @Success 200 {object} models.CumulativeFlow
+// @Router /projects/{project_id}/analytics/cumulative-flow [get]
+func (ac *AnalyticsControllerImpl) CumulativeFlow(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ac.svc.GetCumulativeFlow(context.Background(), customerID.(string), c.Params("project_id"), c.Query("from"), c.Query("to"))
+	if err != nil {
+		return analyticsError(c, err)
+	}
+	return sendReport(c, "cumulative-flow", out)
+}
+
+// @Summary Lead and cycle time distribution
+// @Tags Analytics
+// @Param project_id path string true "Project ID"
+// @Param from query string false "YYYY-MM-DD"
+// @Param to query string false "YYYY-MM-DD"
+// @Param format query string false "json (default) or csv"
+// @Success 200 {object} models.FlowTimes
+// @Router /projects/{project_id}/analytics/flow-times [get]
+func (ac *AnalyticsControllerImpl) FlowTimes(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := ac.svc.GetFlowTimes(context.Background(), customerID.(string), c.Params("project_id"), c.Query("from"), c.Query("to"))
+	if err != nil {
+		return analyticsError(c, err)
+	}
+	return sendReport(c, "flow-times", out)
+}
+
+// sendReport answers with JSON, or with a CSV download for ?format=csv.
+func sendReport(c *fiber.Ctx, name string, r csvReport) error {
+	switch c.Query("format") {
+	case "", "json":
+		return helpers.Success(c, r)
+	case "csv":
+	default:
+		return helpers.Error(c, fiber.StatusBadRequest, "format must be json or csv")
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(r.CSV()); err != nil {
+		return helpers.Error(c, fiber.StatusInternalServerError, err.Error())
+	}
+
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, `attachment; filename="`+name+`.csv"`)
+	return c.Send(buf.Bytes())
+}
+
+func analyticsError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, models.ErrSprintNotFound) {
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
+	}
+	return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+}
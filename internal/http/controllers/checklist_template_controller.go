@@ -0,0 +1,159 @@
+package controllers
+
+import (
+	"bugforge-backend/internal/http/controllers/interfaces"
+	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
+	service "bugforge-backend/internal/service/interfaces"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ChecklistTemplateControllerImpl struct {
+	svc service.ChecklistTemplateService
+}
+
+func NewChecklistTemplateController(s service.ChecklistTemplateService) interfaces.ChecklistTemplateController {
+	return &ChecklistTemplateControllerImpl{svc: s}
+}
+
+type createChecklistTemplateReq struct {
+	Name  string   `json:"name"`
+	Title string   `json:"title"`
+	Items []string `json:"items"`
+}
+
+type updateChecklistTemplateReq struct {
+	Name  *string  `json:"name"`
+	Title *string  `json:"title"`
+	Items []string `json:"items"`
+}
+
+type applyChecklistTemplateReq struct {
+	TemplateID string `json:"template_id"`
+	Version    *int   `json:"version"`
+}
+
+func (tc *ChecklistTemplateControllerImpl) List(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := tc.svc.ListTemplatesByProject(context.Background(), customerID.(string), c.Params("project_id"))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, out)
+}
+
+func (tc *ChecklistTemplateControllerImpl) Get(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	t, err := tc.svc.GetTemplate(context.Background(), customerID.(string), c.Params("project_id"), c.Params("template_id"))
+	if err != nil {
+		return checklistTemplateError(c, err)
+	}
+	return helpers.Success(c, t)
+}
+
+func (tc *ChecklistTemplateControllerImpl) Create(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req createChecklistTemplateReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	t, err := tc.svc.CreateTemplate(context.Background(), customerID.(string), c.Params("project_id"), req.Name, req.Title, req.Items, userID.(string))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, t)
+}
+
+func (tc *ChecklistTemplateControllerImpl) Update(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req updateChecklistTemplateReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	t, err := tc.svc.UpdateTemplate(context.Background(), customerID.(string), c.Params("project_id"), c.Params("template_id"), req.Name, req.Title, req.Items, userID.(string))
+	if err != nil {
+		return checklistTemplateError(c, err)
+	}
+	return helpers.Success(c, t)
+}
+
+func (tc *ChecklistTemplateControllerImpl) Delete(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := tc.svc.DeleteTemplate(context.Background(), customerID.(string), c.Params("project_id"), c.Params("template_id"), userID.(string)); err != nil {
+		return checklistTemplateError(c, err)
+	}
+	return helpers.Success(c, fiber.Map{"deleted": true})
+}
+
+func (tc *ChecklistTemplateControllerImpl) ListVersions(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := tc.svc.ListVersions(context.Background(), customerID.(string), c.Params("project_id"), c.Params("template_id"))
+	if err != nil {
+		return checklistTemplateError(c, err)
+	}
+	return helpers.Success(c, out)
+}
+
+// @Summary Add a checklist to an issue from a template
+// @Tags Checklists
+// @Param id path string true "Issue ID"
+// @Param data body applyChecklistTemplateReq true "Template and optional version"
+// @Success 200 {object} map[string]interface{}
+// @Router /issues/{id}/checklists/from-template [post]
+func (tc *ChecklistTemplateControllerImpl) Apply(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req applyChecklistTemplateReq
+	if err := c.BodyParser(&req); err != nil || req.TemplateID == "" {
+		return helpers.Error(c, fiber.StatusBadRequest, "template_id is required")
+	}
+
+	cl, err := tc.svc.ApplyTemplate(context.Background(), customerID.(string), c.Params("id"), req.TemplateID, req.Version, userID.(string))
+	if err != nil {
+		return checklistTemplateError(c, err)
+	}
+	return helpers.Success(c, cl)
+}
+
+func checklistTemplateError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, models.ErrChecklistTemplateNotFound) {
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
+	}
+	return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+}
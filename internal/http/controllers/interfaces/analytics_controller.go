@@ -0,0 +1,10 @@
+package interfaces
+
+import "github.com/gofiber/fiber/v2"
+
+type AnalyticsController interface {
+	Burndown(c *fiber.Ctx) error
+	Velocity(c *fiber.Ctx) error
+	CumulativeFlow(c *fiber.Ctx) error
+	FlowTimes(c *fiber.Ctx) error
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import "github.com/gofiber/fiber/v2"
+
+type ChecklistTemplateController interface {
+	List(c *fiber.Ctx) error
+	Get(c *fiber.Ctx) error
+	Create(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	ListVersions(c *fiber.Ctx) error
+	Apply(c *fiber.Ctx) error
+}
@@ -9,6 +9,13 @@ type IssueController interface {
 	ListByProject(c *fiber.Ctx) error
 	Update(c *fiber.Ctx) error
 	Delete(c *fiber.Ctx) error
+	Restore(c *fiber.Ctx) error
+	ListTrash(c *fiber.Ctx) error
+	Move(c *fiber.Ctx) error
+	Clone(c *fiber.Ctx) error
+	Demote(c *fiber.Ctx) error
+	Tree(c *fiber.Ctx) error
+	Rollup(c *fiber.Ctx) error
 	
 	ListActivity(c *fiber.Ctx) error
 
@@ -8,6 +8,7 @@ type IssueCommentController interface {
 	List(c *fiber.Ctx) error
 	Update(c *fiber.Ctx) error
 	Delete(c *fiber.Ctx) error
+	Restore(c *fiber.Ctx) error
 }
 
 // Relations
@@ -41,4 +42,5 @@ type IssueSubtaskController interface {
 	Update(c *fiber.Ctx) error
 	List(c *fiber.Ctx) error
 	Delete(c *fiber.Ctx) error
+	Promote(c *fiber.Ctx) error
 }
@@ -8,6 +8,8 @@ type ProjectController interface {
     GetByID(c *fiber.Ctx) error
     Update(c *fiber.Ctx) error
     Delete(c *fiber.Ctx) error
+    ListDeleted(c *fiber.Ctx) error
+    Restore(c *fiber.Ctx) error
 
     // Optional: if you still want subdomain resolution
     GetBySlug(c *fiber.Ctx) error
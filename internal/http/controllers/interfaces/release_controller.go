@@ -0,0 +1,14 @@
+package interfaces
+
+import "github.com/gofiber/fiber/v2"
+
+type ReleaseController interface {
+	List(c *fiber.Ctx) error
+	Get(c *fiber.Ctx) error
+	Create(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	AddIssues(c *fiber.Ctx) error
+	RemoveIssue(c *fiber.Ctx) error
+	Changelog(c *fiber.Ctx) error
+}
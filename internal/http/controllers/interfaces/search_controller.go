@@ -0,0 +1,7 @@
+package interfaces
+
+import "github.com/gofiber/fiber/v2"
+
+type SearchController interface {
+	Search(c *fiber.Ctx) error
+}
@@ -0,0 +1,16 @@
+package interfaces
+
+import "github.com/gofiber/fiber/v2"
+
+type SprintController interface {
+	List(c *fiber.Ctx) error
+	Get(c *fiber.Ctx) error
+	Create(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	Start(c *fiber.Ctx) error
+	Complete(c *fiber.Ctx) error
+	ListIssues(c *fiber.Ctx) error
+	MoveIssues(c *fiber.Ctx) error
+	Rank(c *fiber.Ctx) error
+}
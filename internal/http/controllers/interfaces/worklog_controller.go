@@ -0,0 +1,11 @@
+package interfaces
+
+import "github.com/gofiber/fiber/v2"
+
+type WorklogController interface {
+	List(c *fiber.Ctx) error
+	Create(c *fiber.Ctx) error
+	Update(c *fiber.Ctx) error
+	Delete(c *fiber.Ctx) error
+	Timesheet(c *fiber.Ctx) error
+}
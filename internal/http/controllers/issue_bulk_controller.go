@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"bugforge-backend/internal/http/controllers/interfaces"
+	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
+	service "bugforge-backend/internal/service/interfaces"
+	"context"
+	"encoding/json"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type IssueBulkControllerImpl struct {
+	svc service.IssueBulkService
+}
+
+func NewIssueBulkController(s service.IssueBulkService) interfaces.IssueBulkController {
+	return &IssueBulkControllerImpl{svc: s}
+}
+
+// @Summary Apply one change to many issues of a project
+// @Tags Issues
+// @Param project_id path string true "Project ID"
+// @Param data body models.BulkIssueRequest true "Target issues and changes"
+// @Success 200 {object} map[string]interface{}
+// @Router /projects/{project_id}/issues/bulk [post]
+func (bc *IssueBulkControllerImpl) Apply(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	// Decoded by hand so null in assigned_to / due_date clears the field.
+	var req models.BulkIssueRequest
+	if err := json.Unmarshal(c.Body(), &req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	res, err := bc.svc.Apply(context.Background(), customerID.(string), c.Params("project_id"), userID.(string), req)
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, res)
+}
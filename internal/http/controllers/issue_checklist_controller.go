@@ -6,6 +6,7 @@ import (
 	"bugforge-backend/internal/models"
 	service "bugforge-backend/internal/service/interfaces"
 	"context"
+	"errors"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -67,8 +68,9 @@ func (ic *IssueChecklistControllerImpl) AddItem(c *fiber.Ctx) error {
 }
 
 type updateChecklistItemReq struct {
-	Content string `json:"content"`
-	Done    bool   `json:"done"`
+	Content         string `json:"content"`
+	Done            bool   `json:"done"`
+	ExpectedVersion *int   `json:"expected_version"`
 }
 
 func (ic *IssueChecklistControllerImpl) UpdateItem(c *fiber.Ctx) error {
@@ -84,10 +86,20 @@ func (ic *IssueChecklistControllerImpl) UpdateItem(c *fiber.Ctx) error {
 		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
 	}
 
-	item, err := ic.svc.UpdateChecklistItem(context.Background(), customerID.(string), itemID, req.Content, req.Done, userID.(string))
+	expectedVersion, err := helpers.ExpectedVersion(c, req.ExpectedVersion)
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	item, err := ic.svc.UpdateChecklistItem(context.Background(), customerID.(string), itemID, req.Content, req.Done, expectedVersion, userID.(string))
+	var conflict *models.VersionConflictError
+	if errors.As(err, &conflict) {
+		return helpers.Conflict(c, conflict)
+	}
 	if err != nil {
 		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
 	}
+	helpers.SetETag(c, item.Version)
 	return helpers.Success(c, item)
 }
 
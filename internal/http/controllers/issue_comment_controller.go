@@ -3,8 +3,10 @@ package controllers
 import (
 	"bugforge-backend/internal/http/controllers/interfaces"
 	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
 	service "bugforge-backend/internal/service/interfaces"
 	"context"
+	"errors"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -48,15 +50,22 @@ func (ic *IssueCommentControllerImpl) List(c *fiber.Ctx) error {
 		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
 	}
 	issueID := c.Params("id")
-	out, err := ic.svc.ListComments(context.Background(), customerID.(string), issueID)
+
+	page, err := helpers.ParsePageRequest(c)
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	out, info, err := ic.svc.ListComments(context.Background(), customerID.(string), issueID, page)
 	if err != nil {
 		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
 	}
-	return helpers.Success(c, out)
+	return helpers.Paginated(c, out, info)
 }
 
 type updateCommentReq struct {
-	Body string `json:"body"`
+	Body            string `json:"body"`
+	ExpectedVersion *int   `json:"expected_version"`
 }
 
 func (ic *IssueCommentControllerImpl) Update(c *fiber.Ctx) error {
@@ -70,10 +79,19 @@ func (ic *IssueCommentControllerImpl) Update(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
 	}
-	comment, err := ic.svc.UpdateComment(context.Background(), customerID.(string), commentID, userID.(string), req.Body)
+	expectedVersion, err := helpers.ExpectedVersion(c, req.ExpectedVersion)
 	if err != nil {
 		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
 	}
+	comment, err := ic.svc.UpdateComment(context.Background(), customerID.(string), commentID, userID.(string), req.Body, expectedVersion)
+	var conflict *models.VersionConflictError
+	if errors.As(err, &conflict) {
+		return helpers.Conflict(c, conflict)
+	}
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	helpers.SetETag(c, comment.Version)
 	return helpers.Success(c, comment)
 }
 
@@ -89,3 +107,20 @@ func (ic *IssueCommentControllerImpl) Delete(c *fiber.Ctx) error {
 	}
 	return helpers.Success(c, fiber.Map{"deleted": true})
 }
+
+func (ic *IssueCommentControllerImpl) Restore(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+	commentID := c.Params("comment_id")
+	comment, err := ic.svc.RestoreComment(context.Background(), customerID.(string), commentID, userID.(string))
+	if errors.Is(err, models.ErrNotInTrash) {
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, comment)
+}
@@ -3,9 +3,11 @@ package controllers
 import (
 	"bugforge-backend/internal/http/controllers/interfaces"
 	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
 	service "bugforge-backend/internal/service/interfaces"
 	"context"
-	"fmt"
+	"encoding/json"
+	"errors"
 	"net/url"
 	"time"
 
@@ -25,7 +27,10 @@ type createIssueReq struct {
 	Title       string  `json:"title"`
 	Description string  `json:"description"`
 	Priority    string  `json:"priority"`
+	IssueType   string  `json:"issue_type"`
 	AssignedTo  *string `json:"assigned_to"`
+	ParentIssueID *string `json:"parent_issue_id"`
+	TemplateID  *string `json:"template_id"`
 }
 
 // @Summary Create a new issue
@@ -45,7 +50,7 @@ func (it *IssueControllerImpl) Create(c *fiber.Ctx) error {
 		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
 	}
 
-	issue, err := it.svc.CreateIssue(context.Background(), customerID.(string), req.ProjectID, req.Title, req.Description, req.Priority, req.AssignedTo, userID.(string))
+	issue, err := it.svc.CreateIssue(context.Background(), customerID.(string), req.ProjectID, req.Title, req.Description, req.Priority, req.IssueType, req.AssignedTo, req.ParentIssueID, req.TemplateID, userID.(string))
 	if err != nil {
 		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
 	}
@@ -62,6 +67,7 @@ func (it *IssueControllerImpl) Get(c *fiber.Ctx) error {
 	if err != nil {
 		return helpers.Error(c, fiber.StatusNotFound, err.Error())
 	}
+	helpers.SetETag(c, issue.Version)
 	return helpers.Success(c, issue)
 }
 
@@ -71,12 +77,17 @@ func (it *IssueControllerImpl) ListAll(c *fiber.Ctx) error {
         return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
     }
 
-    issues, err := it.svc.ListAllIssues(context.Background(), customerID.(string))
+    page, err := helpers.ParsePageRequest(c)
     if err != nil {
         return helpers.Error(c, fiber.StatusBadRequest, err.Error())
     }
 
-    return helpers.Success(c, issues)
+    issues, info, err := it.svc.ListAllIssues(context.Background(), customerID.(string), page)
+    if err != nil {
+        return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+    }
+
+    return helpers.Paginated(c, issues, info)
 }
 
 
@@ -107,15 +118,16 @@ func (it *IssueControllerImpl) ListByProject(c *fiber.Ctx) error {
 }
 
 
+// updateIssueReq is a JSON merge patch (RFC 7396): absent fields are left
+// alone and null clears a field.
 type updateIssueReq struct {
-	Title       *string `json:"title"`
-	Description *string `json:"description"`
-	Status      *string `json:"status"`
-	Priority    *string `json:"priority"`
-	AssignedTo  *string `json:"assigned_to"`
+	models.IssuePatch
+
+	// Legacy camelCase alias of assigned_to.
+	AssignedToCamel models.PatchField[string] `json:"assignedTo"`
 
-	AssignedToSnake *string `json:"assigned_to"`
-    AssignedToCamel *string `json:"assignedTo"`
+	// Alternative to the If-Match header.
+	ExpectedVersion *int `json:"expected_version"`
 }
 
 func (it *IssueControllerImpl) Update(c *fiber.Ctx) error {
@@ -126,57 +138,199 @@ func (it *IssueControllerImpl) Update(c *fiber.Ctx) error {
 	}
 
 	id := c.Params("id")
+
+	// Decoded by hand: BodyParser only accepts application/json, and merge
+	// patches are sent as application/merge-patch+json.
 	var req updateIssueReq
-	if err := c.BodyParser(&req); err != nil {
+	if err := json.Unmarshal(c.Body(), &req); err != nil {
 		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
 	}
+	if !req.AssignedTo.Set && req.AssignedToCamel.Set {
+		req.AssignedTo = req.AssignedToCamel
+	}
 
-	var title, description, status, priority string
-	var assignedTo *string
+	expectedVersion, err := helpers.ExpectedVersion(c, req.ExpectedVersion)
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
 
-	if req.AssignedToSnake != nil {
-		assignedTo = req.AssignedToSnake
+	issue, err := it.svc.UpdateIssue(context.Background(), customerID.(string), id, req.IssuePatch, expectedVersion, userID.(string))
+	var conflict *models.VersionConflictError
+	if errors.As(err, &conflict) {
+		return helpers.Conflict(c, conflict)
 	}
-	if req.AssignedToCamel != nil {
-		assignedTo = req.AssignedToCamel
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
 	}
-	fmt.Println("Updated issues ", assignedTo);
-	if req.Title != nil {
-		title = *req.Title
+	helpers.SetETag(c, issue.Version)
+	return helpers.Success(c, issue)
+}
+
+func (it *IssueControllerImpl) Delete(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
 	}
-	if req.Description != nil {
-		description = *req.Description
+	id := c.Params("id")
+	if err := it.svc.DeleteIssue(context.Background(), customerID.(string), id, userID.(string)); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
 	}
-	if req.Status != nil {
-		status = *req.Status
+	return helpers.Success(c, fiber.Map{"deleted": true})
+}
+
+func (it *IssueControllerImpl) Restore(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
 	}
-	if req.Priority != nil {
-		priority = *req.Priority
+	id := c.Params("id")
+	issue, err := it.svc.RestoreIssue(context.Background(), customerID.(string), id, userID.(string))
+	if errors.Is(err, models.ErrNotInTrash) {
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
 	}
-	if req.AssignedTo != nil {
-		assignedTo = req.AssignedTo
+	if err != nil {
+		return helpers.Error(c, columnStatus(err), err.Error())
 	}
+	return helpers.Success(c, issue)
+}
 
-	issue, err := it.svc.UpdateIssue(context.Background(), customerID.(string), id, title, description, status, priority, assignedTo, userID.(string))
+func (it *IssueControllerImpl) ListTrash(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+	projectID := c.Params("project_id")
+	items, err := it.svc.ListTrash(context.Background(), customerID.(string), projectID)
 	if err != nil {
 		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
 	}
+	return helpers.Success(c, items)
+}
+
+
+// @Summary Move an issue to another project
+// @Tags Issues
+// @Param id path string true "Issue ID"
+// @Param data body models.MoveIssueRequest true "Target project"
+// @Success 200 {object} map[string]interface{}
+// @Router /issues/{id}/move [post]
+func (it *IssueControllerImpl) Move(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req models.MoveIssueRequest
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	res, err := it.svc.MoveIssue(context.Background(), customerID.(string), c.Params("id"), req, userID.(string))
+	if err != nil {
+		return helpers.Error(c, columnStatus(err), err.Error())
+	}
+	return helpers.Success(c, res)
+}
+
+// @Summary Clone an issue, optionally into another project
+// @Tags Issues
+// @Param id path string true "Issue ID"
+// @Param data body models.CloneIssueRequest false "Clone options"
+// @Success 200 {object} map[string]interface{}
+// @Router /issues/{id}/clone [post]
+func (it *IssueControllerImpl) Clone(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req models.CloneIssueRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+		}
+	}
+
+	issue, err := it.svc.CloneIssue(context.Background(), customerID.(string), c.Params("id"), req, userID.(string))
+	if err != nil {
+		return helpers.Error(c, columnStatus(err), err.Error())
+	}
+	helpers.SetETag(c, issue.Version)
 	return helpers.Success(c, issue)
 }
 
-func (it *IssueControllerImpl) Delete(c *fiber.Ctx) error {
+// columnStatus answers an issue refused by its kanban column (hard WIP
+// limit reached, column archived) with 409, like the board does, and any
+// other failure with 400.
+func columnStatus(err error) int {
+	if errors.Is(err, models.ErrWIPLimitExceeded) || errors.Is(err, models.ErrColumnArchived) {
+		return fiber.StatusConflict
+	}
+	return fiber.StatusBadRequest
+}
+
+// @Summary Turn an issue into a subtask of another issue
+// @Tags Issues
+// @Param id path string true "Issue ID"
+// @Param data body models.DemoteIssueRequest true "New parent issue"
+// @Success 200 {object} map[string]interface{}
+// @Router /issues/{id}/demote [post]
+func (it *IssueControllerImpl) Demote(c *fiber.Ctx) error {
 	customerID := c.Locals("customer_id")
 	userID := c.Locals("user_id")
 	if customerID == nil || userID == nil {
 		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
 	}
-	id := c.Params("id")
-	if err := it.svc.DeleteIssue(context.Background(), customerID.(string), id, userID.(string)); err != nil {
+
+	var req models.DemoteIssueRequest
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	sub, err := it.svc.DemoteIssue(context.Background(), customerID.(string), c.Params("id"), req, userID.(string))
+	if err != nil {
 		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
 	}
-	return helpers.Success(c, fiber.Map{"deleted": true})
+	return helpers.Success(c, sub)
+}
+
+// @Summary Get an issue with its ancestors and all descendants
+// @Tags Issues
+// @Param id path string true "Issue ID"
+// @Success 200 {object} models.IssueTree
+// @Router /issues/{id}/tree [get]
+func (it *IssueControllerImpl) Tree(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+	tree, err := it.svc.GetIssueTree(context.Background(), customerID.(string), c.Params("id"))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
+	}
+	return helpers.Success(c, tree)
 }
 
+// @Summary Roll up the descendants of an epic (status counts, story points, % done)
+// @Tags Issues
+// @Param id path string true "Issue ID"
+// @Success 200 {object} models.IssueRollup
+// @Router /issues/{id}/rollup [get]
+func (it *IssueControllerImpl) Rollup(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+	rollup, err := it.svc.GetIssueRollup(context.Background(), customerID.(string), c.Params("id"))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
+	}
+	return helpers.Success(c, rollup)
+}
 
 func (it *IssueControllerImpl) ListActivity(c *fiber.Ctx) error {
 	customerID := c.Locals("customer_id")
@@ -3,6 +3,7 @@ package controllers
 import (
 	"bugforge-backend/internal/http/controllers/interfaces"
 	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
 	service "bugforge-backend/internal/service/interfaces"
 	"context"
 	"time"
@@ -121,3 +122,31 @@ func (is *IssueSubtaskControllerImpl) Delete(c *fiber.Ctx) error {
 
     return helpers.Success(c, fiber.Map{"deleted": true})
 }
+
+// @Summary Promote a subtask to a full issue
+// @Tags Subtasks
+// @Param id path string true "Parent issue ID"
+// @Param subtask_id path string true "Subtask ID"
+// @Param data body models.PromoteSubtaskRequest false "Target column and priority"
+// @Success 200 {object} map[string]interface{}
+// @Router /issues/{id}/subtasks/{subtask_id}/promote [post]
+func (is *IssueSubtaskControllerImpl) Promote(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req models.PromoteSubtaskRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+		}
+	}
+
+	issue, err := is.svc.PromoteSubtask(context.Background(), customerID.(string), c.Params("id"), c.Params("subtask_id"), req, userID.(string))
+	if err != nil {
+		return helpers.Error(c, columnStatus(err), err.Error())
+	}
+	return helpers.Success(c, issue)
+}
@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"bugforge-backend/internal/http/controllers/interfaces"
+	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
+	service "bugforge-backend/internal/service/interfaces"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type IssueTemplateControllerImpl struct {
+	svc service.IssueTemplateService
+}
+
+func NewIssueTemplateController(s service.IssueTemplateService) interfaces.IssueTemplateController {
+	return &IssueTemplateControllerImpl{svc: s}
+}
+
+type issueTemplateReq struct {
+	Name         string                     `json:"name"`
+	TitlePrefix  string                     `json:"title_prefix"`
+	Description  string                     `json:"description"`
+	Priority     *string                    `json:"priority"`
+	AssignedTo   *string                    `json:"assigned_to"`
+	LabelIDs     []string                   `json:"label_ids"`
+	Checklists   []models.TemplateChecklist `json:"checklists"`
+	Subtasks     []models.TemplateSubtask   `json:"subtasks"`
+	CustomFields map[string]interface{}     `json:"custom_fields"`
+}
+
+func (r issueTemplateReq) toModel() *models.IssueTemplate {
+	return &models.IssueTemplate{
+		Name:         r.Name,
+		TitlePrefix:  r.TitlePrefix,
+		Description:  r.Description,
+		Priority:     r.Priority,
+		AssignedTo:   r.AssignedTo,
+		LabelIDs:     r.LabelIDs,
+		Checklists:   r.Checklists,
+		Subtasks:     r.Subtasks,
+		CustomFields: r.CustomFields,
+	}
+}
+
+func (tc *IssueTemplateControllerImpl) List(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := tc.svc.ListTemplatesByProject(context.Background(), customerID.(string), c.Params("project_id"))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, out)
+}
+
+func (tc *IssueTemplateControllerImpl) Get(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	t, err := tc.svc.GetTemplate(context.Background(), customerID.(string), c.Params("project_id"), c.Params("template_id"))
+	if err != nil {
+		return templateError(c, err)
+	}
+	return helpers.Success(c, t)
+}
+
+// @Summary Create an issue template
+// @Tags Issue Templates
+// @Param project_id path string true "Project ID"
+// @Param data body issueTemplateReq true "Template"
+// @Success 200 {object} map[string]interface{}
+// @Router /projects/{project_id}/templates [post]
+func (tc *IssueTemplateControllerImpl) Create(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req issueTemplateReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	t, err := tc.svc.CreateTemplate(context.Background(), customerID.(string), c.Params("project_id"), req.toModel(), userID.(string))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, t)
+}
+
+// Update replaces the whole template.
+func (tc *IssueTemplateControllerImpl) Update(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req issueTemplateReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	t, err := tc.svc.UpdateTemplate(context.Background(), customerID.(string), c.Params("project_id"), c.Params("template_id"), req.toModel(), userID.(string))
+	if err != nil {
+		return templateError(c, err)
+	}
+	return helpers.Success(c, t)
+}
+
+func (tc *IssueTemplateControllerImpl) Delete(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := tc.svc.DeleteTemplate(context.Background(), customerID.(string), c.Params("project_id"), c.Params("template_id"), userID.(string)); err != nil {
+		return templateError(c, err)
+	}
+	return helpers.Success(c, fiber.Map{"deleted": true})
+}
+
+func templateError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, models.ErrTemplateNotFound) {
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
+	}
+	return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+}
@@ -22,12 +22,17 @@ func (ctrl *NotificationControllerImpl) GetNotifications(c *fiber.Ctx) error {
 		return helpers.Error(c, fiber.StatusBadRequest, "user_id is required")
 	}
 
-	notifications, err := ctrl.ns.GetUserNotifications(userID)
+	page, err := helpers.ParsePageRequest(c)
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	notifications, info, err := ctrl.ns.GetUserNotifications(userID, page)
 	if err != nil {
 		return helpers.Error(c, fiber.StatusInternalServerError, "failed to fetch notifications")
 	}
 
-	return c.JSON(fiber.Map{"data": notifications})
+	return c.JSON(fiber.Map{"data": notifications, "page": helpers.PageLinks(c, info)})
 }
 
 func (ctrl *NotificationControllerImpl) MarkRead(c *fiber.Ctx) error {
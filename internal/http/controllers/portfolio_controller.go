@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"bugforge-backend/internal/http/controllers/interfaces"
+	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
+	service "bugforge-backend/internal/service/interfaces"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PortfolioControllerImpl struct {
+	svc service.PortfolioService
+}
+
+func NewPortfolioController(s service.PortfolioService) interfaces.PortfolioController {
+	return &PortfolioControllerImpl{svc: s}
+}
+
+type portfolioColumnReq struct {
+	ID        string   `json:"id"` // keeps an existing column on update
+	Name      string   `json:"name"`
+	ColumnIDs []string `json:"column_ids"` // project kanban columns mapped into it
+}
+
+type portfolioReq struct {
+	Name       string               `json:"name"`
+	ProjectIDs []string             `json:"project_ids"`
+	Columns    []portfolioColumnReq `json:"columns"` // in board order
+}
+
+func (r portfolioReq) toModel() *models.Portfolio {
+	p := &models.Portfolio{
+		Name:       r.Name,
+		ProjectIDs: r.ProjectIDs,
+		Columns:    make([]models.PortfolioColumn, len(r.Columns)),
+	}
+	for n, col := range r.Columns {
+		p.Columns[n] = models.PortfolioColumn{ID: col.ID, Name: col.Name, ColumnIDs: col.ColumnIDs}
+	}
+	return p
+}
+
+type portfolioMoveReq struct {
+	ColumnID string `json:"column_id"` // portfolio column
+	Order    int    `json:"order"`     // index in the portfolio column, 0 appends
+}
+
+func (pc *PortfolioControllerImpl) List(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := pc.svc.ListPortfolios(context.Background(), customerID.(string))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, out)
+}
+
+func (pc *PortfolioControllerImpl) Get(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	p, err := pc.svc.GetPortfolio(context.Background(), customerID.(string), c.Params("portfolio_id"))
+	if err != nil {
+		return portfolioError(c, err)
+	}
+	return helpers.Success(c, p)
+}
+
+// @Summary Create a portfolio board
+// @Tags Portfolios
+// @Param data body portfolioReq true "Portfolio"
+// @Success 200 {object} models.Portfolio
+// @Router /portfolios [post]
+func (pc *PortfolioControllerImpl) Create(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req portfolioReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	p, err := pc.svc.CreatePortfolio(context.Background(), customerID.(string), req.toModel(), userID.(string))
+	if err != nil {
+		return portfolioError(c, err)
+	}
+	return helpers.Success(c, p)
+}
+
+// Update replaces the whole definition: name, projects and columns.
+func (pc *PortfolioControllerImpl) Update(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req portfolioReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	p, err := pc.svc.UpdatePortfolio(context.Background(), customerID.(string), c.Params("portfolio_id"), req.toModel(), userID.(string))
+	if err != nil {
+		return portfolioError(c, err)
+	}
+	return helpers.Success(c, p)
+}
+
+func (pc *PortfolioControllerImpl) Delete(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := pc.svc.DeletePortfolio(context.Background(), customerID.(string), c.Params("portfolio_id"), userID.(string)); err != nil {
+		return portfolioError(c, err)
+	}
+	return helpers.Success(c, fiber.Map{"deleted": true})
+}
+
+// @Summary Load a portfolio board
+// @Tags Portfolios
+// @Param portfolio_id path string true "Portfolio ID"
+// @Success 200 {object} models.PortfolioBoard
+// @Router /portfolios/{portfolio_id}/board [get]
+func (pc *PortfolioControllerImpl) Board(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	board, err := pc.svc.GetBoard(context.Background(), customerID.(string), c.Params("portfolio_id"), userID.(string))
+	if err != nil {
+		return portfolioError(c, err)
+	}
+	return helpers.Success(c, board)
+}
+
+// @Summary Move a card into a portfolio column
+// @Tags Portfolios
+// @Param portfolio_id path string true "Portfolio ID"
+// @Param card_id path string true "Card ID"
+// @Param data body portfolioMoveReq true "Target"
+// @Success 200 {object} models.Issue
+// @Router /portfolios/{portfolio_id}/cards/{card_id}/move [patch]
+func (pc *PortfolioControllerImpl) MoveCard(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req portfolioMoveReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	card, err := pc.svc.MoveCard(context.Background(), customerID.(string), c.Params("portfolio_id"), c.Params("card_id"), req.ColumnID, req.Order, userID.(string))
+	if err != nil {
+		return portfolioError(c, err)
+	}
+	return helpers.Success(c, card)
+}
+
+func portfolioError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, models.ErrPortfolioNotFound):
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
+	case errors.Is(err, models.ErrPortfolioNoMapping),
+		errors.Is(err, models.ErrWIPLimitExceeded),
+		errors.Is(err, models.ErrColumnArchived):
+		return helpers.Error(c, fiber.StatusConflict, err.Error())
+	}
+	return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+}
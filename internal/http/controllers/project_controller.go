@@ -3,7 +3,9 @@ package controllers
 import (
 	controller "bugforge-backend/internal/http/controllers/interfaces"
 	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
 	service "bugforge-backend/internal/service/interfaces"
+	"errors"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -117,14 +119,46 @@ func (pc *ProjectControllerImpl) Update(c *fiber.Ctx) error {
 func (pc *ProjectControllerImpl) Delete(c *fiber.Ctx) error {
 	id := c.Params("id")
 	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
 
-	if err := pc.projectService.DeleteProject(c.Context(), id, customerID.(string)); err != nil {
+	err := pc.projectService.DeleteProject(c.Context(), id, customerID.(string), userID.(string))
+	if errors.Is(err, models.ErrProjectNotFound) {
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
+	}
+	if err != nil {
 		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
 	}
 
 	return helpers.Success(c, fiber.Map{"deleted": true})
 }
 
+// ListDeleted returns the customer's projects that are in the trash.
+func (pc *ProjectControllerImpl) ListDeleted(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+
+	projects, err := pc.projectService.ListDeletedProjects(c.Context(), customerID.(string))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	return helpers.Success(c, projects)
+}
+
+func (pc *ProjectControllerImpl) Restore(c *fiber.Ctx) error {
+	id := c.Params("id")
+	customerID := c.Locals("customer_id")
+
+	proj, err := pc.projectService.RestoreProject(c.Context(), id, customerID.(string))
+	if errors.Is(err, models.ErrNotInTrash) {
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	return helpers.Success(c, proj)
+}
+
 
 func (pc *ProjectControllerImpl) GetBySlug(c *fiber.Ctx) error {
 	slug := c.Params("slug")
@@ -143,11 +177,16 @@ func (c *ProjectControllerImpl) GetProjectActivity(ctx *fiber.Ctx) error {
     customerID := ctx.Locals("customer_id").(string)
     projectID := ctx.Params("project_id")
 
-    activities, err := c.projectService.ListProjectActivity(ctx.Context(), customerID, projectID)
+    page, err := helpers.ParsePageRequest(ctx)
+    if err != nil {
+        return helpers.Error(ctx, 400, err.Error())
+    }
+
+    activities, info, err := c.projectService.ListProjectActivity(ctx.Context(), customerID, projectID, page)
     if err != nil {
         return helpers.Error(ctx, 400, err.Error())
     }
 
-    return helpers.Success(ctx, activities)
+    return helpers.Paginated(ctx, activities, info)
 }
 
@@ -20,12 +20,17 @@ func (pc *ProjectMemberController) List(c *fiber.Ctx) error {
 	customerID := c.Locals("customer_id").(string)
 	projectID := c.Params("project_id")
 
-	out, err := pc.service.ListMembers(c.Context(), projectID, customerID)
+	page, err := helpers.ParsePageRequest(c)
 	if err != nil {
 		return helpers.Error(c, 400, err.Error())
 	}
 
-	return helpers.Success(c, out)
+	out, info, err := pc.service.ListMembers(c.Context(), projectID, customerID, page)
+	if err != nil {
+		return helpers.Error(c, 400, err.Error())
+	}
+
+	return helpers.Paginated(c, out, info)
 }
 
 type addMemberReq struct {
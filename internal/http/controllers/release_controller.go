@@ -0,0 +1,203 @@
+package controllers
+
+import (
+	"bugforge-backend/internal/http/controllers/interfaces"
+	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
+	service "bugforge-backend/internal/service/interfaces"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReleaseControllerImpl struct {
+	svc service.ReleaseService
+}
+
+func NewReleaseController(s service.ReleaseService) interfaces.ReleaseController {
+	return &ReleaseControllerImpl{svc: s}
+}
+
+type releaseReq struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	TargetDate  *time.Time `json:"target_date"`
+	Released    bool       `json:"released"`
+}
+
+func (r releaseReq) toModel() *models.Release {
+	return &models.Release{
+		Name:        r.Name,
+		Description: r.Description,
+		TargetDate:  r.TargetDate,
+		Released:    r.Released,
+	}
+}
+
+type releaseIssuesReq struct {
+	IssueIDs []string `json:"issue_ids"`
+}
+
+func (rc *ReleaseControllerImpl) List(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := rc.svc.ListReleases(context.Background(), customerID.(string), c.Params("project_id"))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, out)
+}
+
+func (rc *ReleaseControllerImpl) Get(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	r, err := rc.svc.GetRelease(context.Background(), customerID.(string), c.Params("project_id"), c.Params("release_id"))
+	if err != nil {
+		return releaseError(c, err)
+	}
+	return helpers.Success(c, r)
+}
+
+// @Summary Create a release
+// @Tags Releases
+// @Param project_id path string true "Project ID"
+// @Param data body releaseReq true "Release"
+// @Success 200 {object} models.Release
+// @Router /projects/{project_id}/releases [post]
+func (rc *ReleaseControllerImpl) Create(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req releaseReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	r, err := rc.svc.CreateRelease(context.Background(), customerID.(string), c.Params("project_id"), req.toModel(), userID.(string))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, r)
+}
+
+// Update replaces the whole release, including the released flag.
+func (rc *ReleaseControllerImpl) Update(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req releaseReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	r, err := rc.svc.UpdateRelease(context.Background(), customerID.(string), c.Params("project_id"), c.Params("release_id"), req.toModel(), userID.(string))
+	if err != nil {
+		return releaseError(c, err)
+	}
+	return helpers.Success(c, r)
+}
+
+func (rc *ReleaseControllerImpl) Delete(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := rc.svc.DeleteRelease(context.Background(), customerID.(string), c.Params("project_id"), c.Params("release_id"), userID.(string)); err != nil {
+		return releaseError(c, err)
+	}
+	return helpers.Success(c, fiber.Map{"deleted": true})
+}
+
+// @Summary Set the release as fix version of issues
+// @Tags Releases
+// @Param project_id path string true "Project ID"
+// @Param release_id path string true "Release ID"
+// @Param data body releaseIssuesReq true "Issue IDs"
+// @Success 200 {object} map[string]interface{}
+// @Router /projects/{project_id}/releases/{release_id}/issues [post]
+func (rc *ReleaseControllerImpl) AddIssues(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req releaseIssuesReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	n, err := rc.svc.AddIssues(context.Background(), customerID.(string), c.Params("project_id"), c.Params("release_id"), req.IssueIDs, userID.(string))
+	if err != nil {
+		return releaseError(c, err)
+	}
+	return helpers.Success(c, fiber.Map{"updated": n})
+}
+
+func (rc *ReleaseControllerImpl) RemoveIssue(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	err := rc.svc.RemoveIssue(context.Background(), customerID.(string), c.Params("project_id"), c.Params("release_id"), c.Params("issue_id"), userID.(string))
+	if err != nil {
+		return releaseError(c, err)
+	}
+	return helpers.Success(c, fiber.Map{"removed": true})
+}
+
+// @Summary Generate the changelog of a release
+// @Tags Releases
+// @Param project_id path string true "Project ID"
+// @Param release_id path string true "Release ID"
+// @Param format query string false "markdown (default), html or json"
+// @Success 200 {string} string
+// @Router /projects/{project_id}/releases/{release_id}/changelog [get]
+func (rc *ReleaseControllerImpl) Changelog(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	projectID, releaseID := c.Params("project_id"), c.Params("release_id")
+
+	if c.Query("format") == "json" {
+		cl, err := rc.svc.GetChangelog(context.Background(), customerID.(string), projectID, releaseID)
+		if err != nil {
+			return releaseError(c, err)
+		}
+		return helpers.Success(c, cl)
+	}
+
+	body, contentType, err := rc.svc.RenderChangelog(context.Background(), customerID.(string), projectID, releaseID, c.Query("format"))
+	if err != nil {
+		return releaseError(c, err)
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	return c.SendString(body)
+}
+
+func releaseError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, models.ErrReleaseNotFound) {
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
+	}
+	return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+}
@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"bugforge-backend/internal/http/controllers/interfaces"
+	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
+	service "bugforge-backend/internal/service/interfaces"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SearchControllerImpl struct {
+	svc service.SearchService
+}
+
+func NewSearchController(s service.SearchService) interfaces.SearchController {
+	return &SearchControllerImpl{svc: s}
+}
+
+// @Summary Search issues, comments, projects and users
+// @Tags Search
+// @Param q query string true "Search text"
+// @Param types query string false "Comma separated result types (issue,comment,project,user)"
+// @Param project_id query string false "Restrict results to one project"
+// @Param limit query int false "Max results (default 20, max 100)"
+// @Success 200 {object} map[string]interface{}
+// @Router /search [get]
+func (sc *SearchControllerImpl) Search(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var types []string
+	if v := c.Query("types"); v != "" {
+		for _, t := range strings.Split(v, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				types = append(types, t)
+			}
+		}
+	}
+
+	var projectID *string
+	if v := c.Query("project_id"); v != "" {
+		projectID = &v
+	}
+
+	results, err := sc.svc.Search(
+		c.Context(),
+		customerID.(string),
+		c.Query("q"),
+		types,
+		projectID,
+		c.QueryInt("limit", 0),
+	)
+	if errors.Is(err, models.ErrInvalidSearch) {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	if err != nil {
+		return helpers.Error(c, fiber.StatusInternalServerError, "search failed")
+	}
+
+	return helpers.Success(c, results)
+}
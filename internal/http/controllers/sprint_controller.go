@@ -0,0 +1,245 @@
+package controllers
+
+import (
+	"bugforge-backend/internal/http/controllers/interfaces"
+	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
+	service "bugforge-backend/internal/service/interfaces"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SprintControllerImpl struct {
+	svc service.SprintService
+}
+
+func NewSprintController(s service.SprintService) interfaces.SprintController {
+	return &SprintControllerImpl{svc: s}
+}
+
+type sprintReq struct {
+	Name      string     `json:"name"`
+	Goal      string     `json:"goal"`
+	StartDate *time.Time `json:"start_date"`
+	EndDate   *time.Time `json:"end_date"`
+}
+
+func (r sprintReq) toModel() *models.Sprint {
+	return &models.Sprint{
+		Name:      r.Name,
+		Goal:      r.Goal,
+		StartDate: r.StartDate,
+		EndDate:   r.EndDate,
+	}
+}
+
+type sprintIssuesReq struct {
+	IssueIDs []string `json:"issue_ids"`
+}
+
+// sprintParam is nil on the backlog routes.
+func sprintParam(c *fiber.Ctx) *string {
+	if id := c.Params("sprint_id"); id != "" {
+		return &id
+	}
+	return nil
+}
+
+func (sc *SprintControllerImpl) List(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := sc.svc.ListSprints(context.Background(), customerID.(string), c.Params("project_id"))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, out)
+}
+
+func (sc *SprintControllerImpl) Get(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	sp, err := sc.svc.GetSprint(context.Background(), customerID.(string), c.Params("project_id"), c.Params("sprint_id"))
+	if err != nil {
+		return sprintError(c, err)
+	}
+	return helpers.Success(c, sp)
+}
+
+// @Summary Create a sprint
+// @Tags Sprints
+// @Param project_id path string true "Project ID"
+// @Param data body sprintReq true "Sprint"
+// @Success 200 {object} models.Sprint
+// @Router /projects/{project_id}/sprints [post]
+func (sc *SprintControllerImpl) Create(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req sprintReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	sp, err := sc.svc.CreateSprint(context.Background(), customerID.(string), c.Params("project_id"), req.toModel(), userID.(string))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, sp)
+}
+
+// Update replaces name, goal and dates.
+func (sc *SprintControllerImpl) Update(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req sprintReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	sp, err := sc.svc.UpdateSprint(context.Background(), customerID.(string), c.Params("project_id"), c.Params("sprint_id"), req.toModel(), userID.(string))
+	if err != nil {
+		return sprintError(c, err)
+	}
+	return helpers.Success(c, sp)
+}
+
+func (sc *SprintControllerImpl) Delete(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := sc.svc.DeleteSprint(context.Background(), customerID.(string), c.Params("project_id"), c.Params("sprint_id"), userID.(string)); err != nil {
+		return sprintError(c, err)
+	}
+	return helpers.Success(c, fiber.Map{"deleted": true})
+}
+
+// @Summary Start a planned sprint
+// @Tags Sprints
+// @Param project_id path string true "Project ID"
+// @Param sprint_id path string true "Sprint ID"
+// @Success 200 {object} models.Sprint
+// @Router /projects/{project_id}/sprints/{sprint_id}/start [post]
+func (sc *SprintControllerImpl) Start(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	sp, err := sc.svc.StartSprint(context.Background(), customerID.(string), c.Params("project_id"), c.Params("sprint_id"), userID.(string))
+	if err != nil {
+		return sprintError(c, err)
+	}
+	return helpers.Success(c, sp)
+}
+
+// @Summary Complete the active sprint and roll unfinished issues over
+// @Tags Sprints
+// @Param project_id path string true "Project ID"
+// @Param sprint_id path string true "Sprint ID"
+// @Param data body models.CompleteSprintRequest false "Where unfinished issues go"
+// @Success 200 {object} models.SprintReport
+// @Router /projects/{project_id}/sprints/{sprint_id}/complete [post]
+func (sc *SprintControllerImpl) Complete(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req models.CompleteSprintRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+		}
+	}
+
+	report, err := sc.svc.CompleteSprint(context.Background(), customerID.(string), c.Params("project_id"), c.Params("sprint_id"), req, userID.(string))
+	if err != nil {
+		return sprintError(c, err)
+	}
+	return helpers.Success(c, report)
+}
+
+func (sc *SprintControllerImpl) ListIssues(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := sc.svc.ListSprintIssues(context.Background(), customerID.(string), c.Params("project_id"), sprintParam(c))
+	if err != nil {
+		return sprintError(c, err)
+	}
+	return helpers.Success(c, out)
+}
+
+// @Summary Move issues into a sprint (or back to the backlog)
+// @Tags Sprints
+// @Param project_id path string true "Project ID"
+// @Param sprint_id path string true "Sprint ID"
+// @Param data body sprintIssuesReq true "Issue IDs"
+// @Success 200 {object} map[string]interface{}
+// @Router /projects/{project_id}/sprints/{sprint_id}/issues [post]
+func (sc *SprintControllerImpl) MoveIssues(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req sprintIssuesReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	moves, err := sc.svc.MoveIssues(context.Background(), customerID.(string), c.Params("project_id"), sprintParam(c), req.IssueIDs, userID.(string))
+	if err != nil {
+		return sprintError(c, err)
+	}
+	return helpers.Success(c, moves)
+}
+
+// Rank sets the order of a sprint or the backlog from the issue_ids list.
+func (sc *SprintControllerImpl) Rank(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req sprintIssuesReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	if err := sc.svc.RankIssues(context.Background(), customerID.(string), c.Params("project_id"), sprintParam(c), req.IssueIDs); err != nil {
+		return sprintError(c, err)
+	}
+	return helpers.Success(c, fiber.Map{"ranked": len(req.IssueIDs)})
+}
+
+func sprintError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, models.ErrSprintNotFound) {
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
+	}
+	return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+}
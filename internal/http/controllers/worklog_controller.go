@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"bugforge-backend/internal/http/controllers/interfaces"
+	"bugforge-backend/internal/http/helpers"
+	"bugforge-backend/internal/models"
+	service "bugforge-backend/internal/service/interfaces"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type WorklogControllerImpl struct {
+	svc service.WorklogService
+}
+
+func NewWorklogController(s service.WorklogService) interfaces.WorklogController {
+	return &WorklogControllerImpl{svc: s}
+}
+
+type worklogReq struct {
+	Minutes  int    `json:"minutes"`
+	WorkDate string `json:"work_date"` // YYYY-MM-DD, defaults to today
+	Comment  string `json:"comment"`
+}
+
+func (r worklogReq) toModel() *models.Worklog {
+	return &models.Worklog{
+		Minutes:  r.Minutes,
+		WorkDate: r.WorkDate,
+		Comment:  r.Comment,
+	}
+}
+
+func (wc *WorklogControllerImpl) List(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	if customerID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := wc.svc.ListWorklogs(context.Background(), customerID.(string), c.Params("id"))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, out)
+}
+
+// @Summary Log work on an issue
+// @Tags Worklogs
+// @Param id path string true "Issue ID"
+// @Param data body worklogReq true "Worklog"
+// @Success 200 {object} models.Worklog
+// @Router /issues/{id}/worklogs [post]
+func (wc *WorklogControllerImpl) Create(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req worklogReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	w, err := wc.svc.LogWork(context.Background(), customerID.(string), c.Params("id"), req.toModel(), userID.(string))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, w)
+}
+
+func (wc *WorklogControllerImpl) Update(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	var req worklogReq
+	if err := c.BodyParser(&req); err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, "invalid payload")
+	}
+
+	w, err := wc.svc.UpdateWorklog(context.Background(), customerID.(string), c.Params("id"), c.Params("worklog_id"), req.toModel(), userID.(string))
+	if err != nil {
+		return worklogError(c, err)
+	}
+	return helpers.Success(c, w)
+}
+
+func (wc *WorklogControllerImpl) Delete(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := wc.svc.DeleteWorklog(context.Background(), customerID.(string), c.Params("id"), c.Params("worklog_id"), userID.(string)); err != nil {
+		return worklogError(c, err)
+	}
+	return helpers.Success(c, fiber.Map{"deleted": true})
+}
+
+// @Summary Timesheet of a user
+// @Tags Worklogs
+// @Param user_id query string false "User ID (defaults to the caller)"
+// @Param from query string false "YYYY-MM-DD (defaults to six days before to)"
+// @Param to query string false "YYYY-MM-DD (defaults to today)"
+// @Param project_id query string false "Project ID"
+// @Success 200 {object} models.Timesheet
+// @Router /timesheet [get]
+func (wc *WorklogControllerImpl) Timesheet(c *fiber.Ctx) error {
+	customerID := c.Locals("customer_id")
+	userID := c.Locals("user_id")
+	if customerID == nil || userID == nil {
+		return helpers.Error(c, fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	forUser := c.Query("user_id", userID.(string))
+
+	ts, err := wc.svc.GetTimesheet(context.Background(), customerID.(string), forUser, c.Query("from"), c.Query("to"), c.Query("project_id"))
+	if err != nil {
+		return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+	}
+	return helpers.Success(c, ts)
+}
+
+func worklogError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, models.ErrWorklogNotFound) {
+		return helpers.Error(c, fiber.StatusNotFound, err.Error())
+	}
+	return helpers.Error(c, fiber.StatusBadRequest, err.Error())
+}
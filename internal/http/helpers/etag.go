@@ -0,0 +1,46 @@
+package helpers
+
+import (
+	"bugforge-backend/internal/models"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var ErrInvalidIfMatch = errors.New("invalid If-Match header")
+
+// SetETag exposes a row version as a strong ETag ("<version>").
+func SetETag(c *fiber.Ctx, version int) {
+	c.Set(fiber.HeaderETag, `"`+strconv.Itoa(version)+`"`)
+}
+
+// ExpectedVersion reads the version the client based its edit on, from the
+// If-Match header or, failing that, the expected_version given in the body.
+// nil means the update is unconditional.
+func ExpectedVersion(c *fiber.Ctx, fromBody *int) (*int, error) {
+	h := strings.TrimSpace(c.Get(fiber.HeaderIfMatch))
+	if h == "" || h == "*" {
+		return fromBody, nil
+	}
+
+	h = strings.TrimPrefix(h, "W/")
+	h = strings.Trim(h, `"`)
+
+	v, err := strconv.Atoi(h)
+	if err != nil || v <= 0 {
+		return nil, ErrInvalidIfMatch
+	}
+	return &v, nil
+}
+
+// Conflict writes a 409 carrying the server's current copy of the resource.
+func Conflict(c *fiber.Ctx, conflict *models.VersionConflictError) error {
+	SetETag(c, conflict.Version)
+	return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+		"success": false,
+		"message": conflict.Error(),
+		"data":    conflict.Current,
+	})
+}
@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"bugforge-backend/internal/models"
+	"net/url"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ParsePageRequest reads ?cursor=, ?limit= and ?include_total= from the query.
+func ParsePageRequest(c *fiber.Ctx) (models.PageRequest, error) {
+	p := models.PageRequest{
+		Limit:     c.QueryInt("limit", models.DefaultPageLimit),
+		WithTotal: c.QueryBool("include_total", false),
+	}
+
+	if p.Limit <= 0 {
+		p.Limit = models.DefaultPageLimit
+	}
+	if p.Limit > models.MaxPageLimit {
+		p.Limit = models.MaxPageLimit
+	}
+
+	if v := c.Query("cursor"); v != "" {
+		cur, err := models.DecodeCursor(v)
+		if err != nil {
+			return p, err
+		}
+		p.Cursor = cur
+	}
+
+	return p, nil
+}
+
+// PageWithLinks is the "page" object of paginated responses.
+type PageWithLinks struct {
+	models.PageInfo
+	Next *string `json:"next"`
+	Prev *string `json:"prev"`
+}
+
+// Paginated writes a success response with the page cursors and ready-made
+// next/prev links that keep the rest of the caller's query string.
+func Paginated(c *fiber.Ctx, data interface{}, info models.PageInfo) error {
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"data":    data,
+		"page":    PageLinks(c, info),
+	})
+}
+
+// PageLinks decorates info with absolute next/prev URLs.
+func PageLinks(c *fiber.Ctx, info models.PageInfo) PageWithLinks {
+	out := PageWithLinks{PageInfo: info}
+	if info.NextCursor != nil {
+		link := pageLink(c, *info.NextCursor)
+		out.Next = &link
+	}
+	if info.PrevCursor != nil {
+		link := pageLink(c, *info.PrevCursor)
+		out.Prev = &link
+	}
+	return out
+}
+
+func pageLink(c *fiber.Ctx, cursor string) string {
+	q := url.Values{}
+	for k, v := range c.Queries() {
+		q.Set(k, v)
+	}
+	q.Set("cursor", cursor)
+
+	return c.BaseURL() + c.Path() + "?" + q.Encode()
+}
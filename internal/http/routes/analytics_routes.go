@@ -0,0 +1,17 @@
+package routes
+
+import (
+	ctrl "bugforge-backend/internal/http/controllers/interfaces"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AnalyticsRoutes serve JSON by default and CSV with ?format=csv.
+func AnalyticsRoutes(router fiber.Router, ac ctrl.AnalyticsController) {
+	r := router.Group("/projects/:project_id/analytics")
+
+	r.Get("/burndown", ac.Burndown) // ?sprint_id=&unit=points|issues
+	r.Get("/velocity", ac.Velocity) // ?sprints=6
+	r.Get("/cumulative-flow", ac.CumulativeFlow)
+	r.Get("/flow-times", ac.FlowTimes) // lead and cycle time
+}
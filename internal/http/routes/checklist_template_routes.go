@@ -0,0 +1,20 @@
+package routes
+
+import (
+	ctrl "bugforge-backend/internal/http/controllers/interfaces"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func ChecklistTemplateRoutes(router fiber.Router, tc ctrl.ChecklistTemplateController) {
+	r := router.Group("/projects/:project_id/checklist-templates")
+
+	r.Get("/", tc.List)
+	r.Post("/", tc.Create)
+	r.Get("/:template_id", tc.Get)
+	r.Patch("/:template_id", tc.Update)
+	r.Delete("/:template_id", tc.Delete)
+	r.Get("/:template_id/versions", tc.ListVersions)
+
+	router.Post("/issues/:id/checklists/from-template", tc.Apply)
+}
@@ -0,0 +1,11 @@
+package routes
+
+import (
+	ctrl "bugforge-backend/internal/http/controllers/interfaces"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func IssueBulkRoutes(router fiber.Router, bc ctrl.IssueBulkController) {
+	router.Post("/projects/:project_id/issues/bulk", bc.Apply)
+}
@@ -34,6 +34,12 @@ func IssueRoutes(
 	r.Get("/:id", issueCtrl.Get)
 	r.Patch("/:id", issueCtrl.Update)
 	r.Delete("/:id", issueCtrl.Delete)
+	r.Post("/:id/restore", issueCtrl.Restore)
+	r.Post("/:id/move", issueCtrl.Move)
+	r.Post("/:id/clone", issueCtrl.Clone)
+	r.Post("/:id/demote", issueCtrl.Demote)
+	r.Get("/:id/tree", issueCtrl.Tree)
+	r.Get("/:id/rollup", issueCtrl.Rollup)
 
 	// Activity — SAFE here (after /:id but before other wildcards)
 	r.Get("/:id/activity", issueCtrl.ListActivity)
@@ -43,6 +49,7 @@ func IssueRoutes(
 	r.Get("/:id/comments", commentCtrl.List)
 	r.Patch("/:id/comments/:comment_id", commentCtrl.Update)
 	r.Delete("/:id/comments/:comment_id", commentCtrl.Delete)
+	r.Post("/:id/comments/:comment_id/restore", commentCtrl.Restore)
 
 	// Relations
 	r.Post("/:id/relations", relationCtrl.Add)
@@ -74,5 +81,6 @@ func IssueRoutes(
 	r.Patch("/:id/subtasks/:subtask_id", subtaskCtrl.Update)
 	r.Get("/:id/subtasks", subtaskCtrl.List)
 	r.Delete("/:id/subtasks/:subtask_id", subtaskCtrl.Delete)
+	r.Post("/:id/subtasks/:subtask_id/promote", subtaskCtrl.Promote)
 
 }
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	ctrl "bugforge-backend/internal/http/controllers/interfaces"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func IssueTemplateRoutes(router fiber.Router, tc ctrl.IssueTemplateController) {
+	r := router.Group("/projects/:project_id/templates")
+
+	r.Get("/", tc.List)
+	r.Post("/", tc.Create)
+	r.Get("/:template_id", tc.Get)
+	r.Put("/:template_id", tc.Update)
+	r.Delete("/:template_id", tc.Delete)
+}
@@ -2,7 +2,10 @@ package routes
 
 import (
 	"encoding/json"
+	"errors"
+	"net/url"
 
+	"bugforge-backend/internal/models"
 	"bugforge-backend/internal/service"
 	ws "bugforge-backend/internal/websocket"
 
@@ -11,17 +14,183 @@ import (
 
 func RegisterKanbanRoutes(router fiber.Router, kanbanService *service.KanbanServiceImpl, hub *ws.Hub) {
 
-    // GET BOARD
-    router.Get("/projects/:projectID/kanban", func(c *fiber.Ctx) error {
+    // GET BOARD (the project's default board, or the board in the path)
+    getBoard := func(c *fiber.Ctx) error {
         projectID := c.Params("projectID")
+        boardID := c.Params("boardID")
         userID := c.Locals("user_id").(string)
 
-        board, err := kanbanService.GetBoard(projectID, userID)
+        // ?sprint=active|<sprint id> scopes the board to a sprint,
+        // ?swimlanes=assignee|priority|epic|label groups it into lanes,
+        // ?assigned_to=&label_id=&priority=&search=&due_from=&due_to=
+        // &mine=true&updated_within=<days>&quick_filter=<id>,<id> filter the cards
+        q := url.Values{}
+        for k, v := range c.Queries() {
+            q.Set(k, v)
+        }
+
+        board, err := kanbanService.GetBoard(projectID, boardID, userID, q)
         if err != nil {
-            return err
+            return kanbanError(err)
         }
 
         return c.JSON(board)
+    }
+    router.Get("/projects/:projectID/kanban", getBoard)
+    router.Get("/projects/:projectID/boards/:boardID/kanban", getBoard)
+
+    // BOARDS
+    router.Get("/projects/:projectID/boards", func(c *fiber.Ctx) error {
+        projectID := c.Params("projectID")
+        userID := c.Locals("user_id").(string)
+
+        boards, err := kanbanService.ListBoards(projectID, userID)
+        if err != nil {
+            return err
+        }
+        return c.JSON(boards)
+    })
+
+    router.Post("/projects/:projectID/boards", func(c *fiber.Ctx) error {
+        projectID := c.Params("projectID")
+        userID := c.Locals("user_id").(string)
+
+        var body struct {
+            Name   string             `json:"name"`
+            Filter models.BoardFilter `json:"filter"`
+        }
+        if err := c.BodyParser(&body); err != nil {
+            return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+        }
+
+        b, err := kanbanService.CreateBoard(projectID, body.Name, body.Filter, userID)
+        if err != nil {
+            return fiber.NewError(fiber.StatusBadRequest, err.Error())
+        }
+        return c.Status(fiber.StatusCreated).JSON(b)
+    })
+
+    router.Put("/projects/:projectID/boards/:boardID", func(c *fiber.Ctx) error {
+        projectID := c.Params("projectID")
+        boardID := c.Params("boardID")
+        userID := c.Locals("user_id").(string)
+
+        var body struct {
+            Name   string             `json:"name"`
+            Filter models.BoardFilter `json:"filter"`
+        }
+        if err := c.BodyParser(&body); err != nil {
+            return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+        }
+
+        b, err := kanbanService.UpdateBoard(projectID, boardID, body.Name, body.Filter, userID)
+        if err != nil {
+            if errors.Is(err, models.ErrBoardNotFound) {
+                return kanbanError(err)
+            }
+            return fiber.NewError(fiber.StatusBadRequest, err.Error())
+        }
+
+        // WS BROADCAST
+        room := hub.GetRoom(projectID, b.ID)
+        evt := map[string]any{
+            "type":      "board_updated",
+            "projectID": projectID,
+            "board":     b,
+        }
+        msg, _ := json.Marshal(evt)
+        room.Broadcast(msg)
+
+        return c.JSON(b)
+    })
+
+    router.Delete("/projects/:projectID/boards/:boardID", func(c *fiber.Ctx) error {
+        projectID := c.Params("projectID")
+        boardID := c.Params("boardID")
+        userID := c.Locals("user_id").(string)
+
+        if err := kanbanService.DeleteBoard(projectID, boardID, userID); err != nil {
+            return kanbanError(err)
+        }
+
+        // WS BROADCAST
+        room := hub.GetRoom(projectID, boardID)
+        evt := map[string]any{
+            "type":      "board_deleted",
+            "projectID": projectID,
+            "payload": map[string]any{
+                "board_id": boardID,
+            },
+        }
+        b, _ := json.Marshal(evt)
+        room.Broadcast(b)
+
+        return c.JSON(fiber.Map{"status": "ok"})
+    })
+
+    // QUICK FILTERS
+    router.Get("/projects/:projectID/kanban/quick-filters", func(c *fiber.Ctx) error {
+        projectID := c.Params("projectID")
+        userID := c.Locals("user_id").(string)
+
+        filters, err := kanbanService.ListQuickFilters(projectID, userID)
+        if err != nil {
+            return err
+        }
+        return c.JSON(filters)
+    })
+
+    router.Post("/projects/:projectID/kanban/quick-filters", func(c *fiber.Ctx) error {
+        projectID := c.Params("projectID")
+        userID := c.Locals("user_id").(string)
+
+        var body struct {
+            Name   string             `json:"name"`
+            Filter models.BoardFilter `json:"filter"`
+        }
+        if err := c.BodyParser(&body); err != nil {
+            return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+        }
+
+        qf, err := kanbanService.CreateQuickFilter(projectID, body.Name, body.Filter, userID)
+        if err != nil {
+            return fiber.NewError(fiber.StatusBadRequest, err.Error())
+        }
+        return c.Status(fiber.StatusCreated).JSON(qf)
+    })
+
+    router.Put("/projects/:projectID/kanban/quick-filters/:filterID", func(c *fiber.Ctx) error {
+        projectID := c.Params("projectID")
+        filterID := c.Params("filterID")
+        userID := c.Locals("user_id").(string)
+
+        var body struct {
+            Name   string             `json:"name"`
+            Filter models.BoardFilter `json:"filter"`
+        }
+        if err := c.BodyParser(&body); err != nil {
+            return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+        }
+
+        qf, err := kanbanService.UpdateQuickFilter(projectID, filterID, body.Name, body.Filter, userID)
+        if err != nil {
+            if errors.Is(err, models.ErrQuickFilterNotFound) {
+                return kanbanError(err)
+            }
+            return fiber.NewError(fiber.StatusBadRequest, err.Error())
+        }
+        return c.JSON(qf)
+    })
+
+    router.Delete("/projects/:projectID/kanban/quick-filters/:filterID", func(c *fiber.Ctx) error {
+        projectID := c.Params("projectID")
+        filterID := c.Params("filterID")
+        userID := c.Locals("user_id").(string)
+
+        if err := kanbanService.DeleteQuickFilter(projectID, filterID, userID); err != nil {
+            return kanbanError(err)
+        }
+        return c.JSON(fiber.Map{"status": "ok"})
     })
 
     // CREATE COLUMN
@@ -29,16 +198,19 @@ func RegisterKanbanRoutes(router fiber.Router, kanbanService *service.KanbanServ
         projectID := c.Params("projectID")
         userID := c.Locals("user_id").(string)
 
-        var body struct{ Name string }
+        var body struct {
+            Name    string
+            BoardID string `json:"board_id"` // defaults to the project's default board
+        }
         c.BodyParser(&body)
 
-        col, err := kanbanService.CreateColumn(projectID, body.Name, userID)
+        col, err := kanbanService.CreateColumn(projectID, body.BoardID, body.Name, userID)
         if err != nil {
-            return err
+            return kanbanError(err)
         }
 
         // ---> WS BROADCAST
-        room := hub.GetRoom(projectID)
+        room := hub.GetRoom(projectID, col.BoardID)
         evt := map[string]any{
             "type":      "column_created",
             "projectID": projectID,
@@ -59,23 +231,25 @@ func RegisterKanbanRoutes(router fiber.Router, kanbanService *service.KanbanServ
         var body struct {
             Title       string
             Description string
+            TemplateID  *string `json:"template_id"`
         }
         c.BodyParser(&body)
 
-        card, err := kanbanService.CreateCard(projectID, columnID, body.Title, body.Description, userID)
+        card, warning, err := kanbanService.CreateCard(projectID, columnID, body.Title, body.Description, body.TemplateID, userID)
         if err != nil {
-            return err
+            return kanbanError(err)
         }
 
-        // ---> WS BROADCAST
-        room := hub.GetRoom(projectID)
+        // ---> WS BROADCAST, to every board: their filters decide where
+        // the card shows
         evt := map[string]any{
             "type":      "card_created",
             "projectID": projectID,
             "card":      card,
         }
         b, _ := json.Marshal(evt)
-        room.Broadcast(b)
+        hub.BroadcastProject(projectID, b)
+        broadcastWIPWarning(hub.GetRoom(projectID, kanbanService.BoardOfColumn(card.ColumnID)), projectID, card.ID, warning)
 
         return c.JSON(card)
     })
@@ -86,20 +260,20 @@ func RegisterKanbanRoutes(router fiber.Router, kanbanService *service.KanbanServ
         userID := c.Locals("user_id").(string)
 
         var body struct {
-            ColumnID string `json:"columnId"`
-            Order    int    `json:"order"`
+            ColumnID string           `json:"columnId"`
+            Order    int              `json:"order"`
+            Lane     *models.LaneMove `json:"lane"` // optional drop into another swimlane
         }
         if err := c.BodyParser(&body); err != nil {
             return fiber.NewError(fiber.StatusBadRequest, "invalid body")
         }
 
-        updatedCard, fromColumnID, err := kanbanService.MoveCard(cardID, body.ColumnID, body.Order, userID)
+        updatedCard, fromColumnID, warning, err := kanbanService.MoveCard(cardID, body.ColumnID, body.Order, body.Lane, userID)
         if err != nil {
-            return err
+            return kanbanError(err)
         }
 
-        // Broadcast WS event
-        room := hub.GetRoom(updatedCard.ProjectID)
+        // Broadcast WS event to every board of the project
         evt := map[string]any{
             "type": "card_moved",
             "projectID": updatedCard.ProjectID,
@@ -108,10 +282,13 @@ func RegisterKanbanRoutes(router fiber.Router, kanbanService *service.KanbanServ
                 "from_column": fromColumnID,
                 "to_column":   updatedCard.ColumnID,
                 "new_order":   updatedCard.Order,
+                "lane":        body.Lane,
             },
         }
         b, _ := json.Marshal(evt)
-        room.Broadcast(b)
+        hub.BroadcastProject(updatedCard.ProjectID, b)
+        room := hub.GetRoom(updatedCard.ProjectID, kanbanService.BoardOfColumn(updatedCard.ColumnID))
+        broadcastWIPWarning(room, updatedCard.ProjectID, updatedCard.ID, warning)
 
         return c.JSON(updatedCard)
     })
@@ -136,7 +313,7 @@ func RegisterKanbanRoutes(router fiber.Router, kanbanService *service.KanbanServ
         }
 
         // WS broadcast
-        room := hub.GetRoom(projectID)
+        room := hub.GetRoom(projectID, kanbanService.BoardOfColumn(body.ColumnID))
         evt := map[string]any{
             "type":      "column_reordered",
             "projectID": projectID,
@@ -170,7 +347,7 @@ func RegisterKanbanRoutes(router fiber.Router, kanbanService *service.KanbanServ
         }
 
         // WS BROADCAST
-        room := hub.GetRoom(projectID)
+        room := hub.GetRoom(projectID, updatedCol.BoardID)
         evt := map[string]any{
             "type": "column_renamed",
             "payload": map[string]any{
@@ -185,31 +362,130 @@ func RegisterKanbanRoutes(router fiber.Router, kanbanService *service.KanbanServ
     })
 
 
-    // DELETE COLUMN (and all cards inside it)
-    router.Delete("/projects/:projectID/columns/:columnID", func(c *fiber.Ctx) error {
+    // SET COLUMN WIP LIMIT (null wip_limit removes it)
+    router.Put("/projects/:projectID/columns/:columnID/wip-limit", func(c *fiber.Ctx) error {
         projectID := c.Params("projectID")
         columnID := c.Params("columnID")
         userID := c.Locals("user_id").(string)
 
-        err := kanbanService.DeleteColumn(projectID, columnID, userID)
+        var body struct {
+            WIPLimit *int  `json:"wip_limit"`
+            WIPMode string `json:"wip_mode"` // soft | hard
+        }
+        if err := c.BodyParser(&body); err != nil {
+            return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+        }
+
+        col, err := kanbanService.SetColumnWIPLimit(projectID, columnID, body.WIPLimit, body.WIPMode, userID)
         if err != nil {
-            return err
+            return kanbanError(err)
         }
 
         // WS BROADCAST
-        room := hub.GetRoom(projectID)
+        room := hub.GetRoom(projectID, col.BoardID)
         evt := map[string]any{
-            "type": "column_deleted",
+            "type": "column_wip_limit_changed",
             "payload": map[string]any{
                 "column_id": columnID,
+                "wip_limit": col.WIPLimit,
+                "wip_mode":  col.WIPMode,
             },
         }
         b, _ := json.Marshal(evt)
         room.Broadcast(b)
 
-        return c.JSON(fiber.Map{"status": "ok"})
+        return c.JSON(col)
+    })
+
+    // DELETE COLUMN (?target_column_id= receives its cards)
+    router.Delete("/projects/:projectID/columns/:columnID", func(c *fiber.Ctx) error {
+        projectID := c.Params("projectID")
+        columnID := c.Params("columnID")
+        targetColumnID := c.Query("target_column_id")
+        userID := c.Locals("user_id").(string)
+
+        // The column is gone afterwards, so look up its board first
+        boardID := kanbanService.BoardOfColumn(columnID)
+
+        moved, warning, err := kanbanService.DeleteColumn(projectID, columnID, targetColumnID, userID)
+        if err != nil {
+            return kanbanError(err)
+        }
+
+        // WS BROADCAST, on the target's board too when it is another one
+        relocated := make([]map[string]any, 0, len(moved))
+        for _, card := range moved {
+            relocated = append(relocated, map[string]any{
+                "card_id":   card.ID,
+                "column_id": card.ColumnID,
+                "new_order": card.Order,
+            })
+        }
+        room := hub.GetRoom(projectID, boardID)
+        evt := map[string]any{
+            "type": "column_deleted",
+            "payload": map[string]any{
+                "column_id":        columnID,
+                "target_column_id": targetColumnID,
+                "cards":            relocated,
+            },
+        }
+        b, _ := json.Marshal(evt)
+        room.Broadcast(b)
+        if targetColumnID != "" {
+            targetBoardID := kanbanService.BoardOfColumn(targetColumnID)
+            if targetBoardID != boardID {
+                hub.GetRoom(projectID, targetBoardID).Broadcast(b)
+            }
+            // The last card moved in is the one past a soft limit
+            if len(moved) > 0 {
+                broadcastWIPWarning(hub.GetRoom(projectID, targetBoardID), projectID, moved[len(moved)-1].ID, warning)
+            }
+        }
+
+        return c.JSON(fiber.Map{"status": "ok", "cards": moved, "wip_warning": warning})
     })
 
+    // ARCHIVE / UNARCHIVE COLUMN (the cards stay in it)
+    setArchived := func(archive bool) fiber.Handler {
+        return func(c *fiber.Ctx) error {
+            projectID := c.Params("projectID")
+            columnID := c.Params("columnID")
+            userID := c.Locals("user_id").(string)
+
+            var col *models.KanbanColumn
+            var err error
+            if archive {
+                col, err = kanbanService.ArchiveColumn(projectID, columnID, userID)
+            } else {
+                col, err = kanbanService.UnarchiveColumn(projectID, columnID, userID)
+            }
+            if err != nil {
+                return err
+            }
+
+            // WS BROADCAST
+            evtType := "column_unarchived"
+            if archive {
+                evtType = "column_archived"
+            }
+            room := hub.GetRoom(projectID, col.BoardID)
+            evt := map[string]any{
+                "type":    evtType,
+                "payload": map[string]any{
+                    "column_id": columnID,
+                    "column":    col,
+                },
+            }
+            b, _ := json.Marshal(evt)
+            room.Broadcast(b)
+
+            return c.JSON(col)
+        }
+    }
+    router.Post("/projects/:projectID/columns/:columnID/archive", setArchived(true))
+    router.Post("/projects/:projectID/columns/:columnID/unarchive", setArchived(false))
+
     // DELETE CARD
     router.Delete("/kanban/cards/:cardID", func(c *fiber.Ctx) error {
         cardID := c.Params("cardID")
@@ -221,19 +497,59 @@ func RegisterKanbanRoutes(router fiber.Router, kanbanService *service.KanbanServ
         }
 
         // WS BROADCAST
-        room := hub.GetRoom(deletedCard.ProjectID)
         evt := map[string]any{
             "type":       "card_deleted",
-            "project_id": deletedCard.ProjectID,
+            "projectID":  deletedCard.ProjectID,
             "payload": map[string]any{
                 "card_id":   deletedCard.ID,
                 "column_id": deletedCard.ColumnID,
             },
         }
         b, _ := json.Marshal(evt)
-        room.Broadcast(b)
+        hub.BroadcastProject(deletedCard.ProjectID, b)
 
         return c.JSON(fiber.Map{"success": true})
     })
 
 }
+
+// broadcastWIPWarning tells the board a card went past a soft WIP limit.
+func broadcastWIPWarning(room *ws.Room, projectID, cardID string, w *models.WIPWarning) {
+    if w == nil {
+        return
+    }
+    evt := map[string]any{
+        "type":      "wip_limit_exceeded",
+        "projectID": projectID,
+        "payload": map[string]any{
+            "card_id": cardID,
+            "warning": w,
+        },
+    }
+    b, _ := json.Marshal(evt)
+    room.Broadcast(b)
+}
+
+// kanbanError maps a hard WIP limit rejection, archived columns and
+// refused board deletes to 409, a column delete without a target, invalid
+// WIP settings, invalid filters and a filter on the default board to 400,
+// and unknown boards and quick filters to 404.
+func kanbanError(err error) error {
+    if errors.Is(err, models.ErrColumnTargetRequired) ||
+        errors.Is(err, models.ErrDefaultBoardFilter) ||
+        errors.Is(err, models.ErrInvalidBoardFilter) ||
+        errors.Is(err, models.ErrInvalidWIPLimit) ||
+        errors.Is(err, models.ErrInvalidWIPMode) {
+        return fiber.NewError(fiber.StatusBadRequest, err.Error())
+    }
+    if errors.Is(err, models.ErrWIPLimitExceeded) ||
+        errors.Is(err, models.ErrColumnArchived) ||
+        errors.Is(err, models.ErrDefaultBoardDelete) ||
+        errors.Is(err, models.ErrBoardNotEmpty) {
+        return fiber.NewError(fiber.StatusConflict, err.Error())
+    }
+    if errors.Is(err, models.ErrQuickFilterNotFound) || errors.Is(err, models.ErrBoardNotFound) {
+        return fiber.NewError(fiber.StatusNotFound, err.Error())
+    }
+    return err
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	ctrl "bugforge-backend/internal/http/controllers/interfaces"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func PortfolioRoutes(router fiber.Router, pc ctrl.PortfolioController) {
+	r := router.Group("/portfolios")
+
+	r.Get("/", pc.List)
+	r.Post("/", pc.Create)
+	r.Get("/:portfolio_id", pc.Get)
+	r.Put("/:portfolio_id", pc.Update)
+	r.Delete("/:portfolio_id", pc.Delete)
+
+	r.Get("/:portfolio_id/board", pc.Board)
+	r.Patch("/:portfolio_id/cards/:card_id/move", pc.MoveCard)
+}
@@ -17,13 +17,18 @@ func ProjectRoutes(router fiber.Router,
 	// Project CRUD
 	r.Post("/", pc.Create)
 	r.Get("/", pc.GetAll)
+	r.Get("/trash", pc.ListDeleted) // before /:id
 	r.Get("/:id", pc.GetByID)
 	r.Put("/:id", pc.Update)
 	r.Delete("/:id", pc.Delete)
+	r.Post("/:id/restore", pc.Restore)
 
 	// Project-specific issue listing
 	r.Get("/:project_id/issues", ic.ListByProject)
 
+	// Trashed issues and comments
+	r.Get("/:project_id/trash", ic.ListTrash)
+
 	// PROJECT ACTIVITY FEED
 	r.Get("/:project_id/activity", pc.GetProjectActivity)
 
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	ctrl "bugforge-backend/internal/http/controllers/interfaces"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func ReleaseRoutes(router fiber.Router, rc ctrl.ReleaseController) {
+	r := router.Group("/projects/:project_id/releases")
+
+	r.Get("/", rc.List)
+	r.Post("/", rc.Create)
+	r.Get("/:release_id", rc.Get)
+	r.Put("/:release_id", rc.Update)
+	r.Delete("/:release_id", rc.Delete)
+
+	r.Post("/:release_id/issues", rc.AddIssues)
+	r.Delete("/:release_id/issues/:issue_id", rc.RemoveIssue)
+
+	// ?format=markdown (default) | html | json
+	r.Get("/:release_id/changelog", rc.Changelog)
+}
@@ -0,0 +1,11 @@
+package routes
+
+import (
+	ctrl "bugforge-backend/internal/http/controllers/interfaces"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SearchRoutes(router fiber.Router, sc ctrl.SearchController) {
+	router.Get("/search", sc.Search)
+}
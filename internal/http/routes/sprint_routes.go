@@ -0,0 +1,30 @@
+package routes
+
+import (
+	ctrl "bugforge-backend/internal/http/controllers/interfaces"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SprintRoutes(router fiber.Router, sc ctrl.SprintController) {
+	r := router.Group("/projects/:project_id/sprints")
+
+	r.Get("/", sc.List)
+	r.Post("/", sc.Create)
+	r.Get("/:sprint_id", sc.Get)
+	r.Put("/:sprint_id", sc.Update)
+	r.Delete("/:sprint_id", sc.Delete)
+
+	r.Post("/:sprint_id/start", sc.Start)
+	r.Post("/:sprint_id/complete", sc.Complete)
+
+	r.Get("/:sprint_id/issues", sc.ListIssues)
+	r.Post("/:sprint_id/issues", sc.MoveIssues)
+	r.Put("/:sprint_id/order", sc.Rank)
+
+	// Backlog = issues without a sprint
+	b := router.Group("/projects/:project_id/backlog")
+	b.Get("/", sc.ListIssues)
+	b.Post("/issues", sc.MoveIssues)
+	b.Put("/order", sc.Rank)
+}
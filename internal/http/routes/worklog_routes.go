@@ -0,0 +1,19 @@
+package routes
+
+import (
+	ctrl "bugforge-backend/internal/http/controllers/interfaces"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func WorklogRoutes(router fiber.Router, wc ctrl.WorklogController) {
+	r := router.Group("/issues/:id/worklogs")
+
+	r.Get("/", wc.List)
+	r.Post("/", wc.Create)
+	r.Put("/:worklog_id", wc.Update)
+	r.Delete("/:worklog_id", wc.Delete)
+
+	// ?user_id=&from=&to=&project_id=
+	router.Get("/timesheet", wc.Timesheet)
+}
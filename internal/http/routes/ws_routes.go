@@ -1,25 +1,79 @@
 package routes
 
 import (
+	"context"
+	"errors"
+
 	mw "bugforge-backend/internal/http/middlewares"
+	"bugforge-backend/internal/models"
+	"bugforge-backend/internal/service"
+	svc "bugforge-backend/internal/service/interfaces"
 	ws "bugforge-backend/internal/websocket"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/websocket/v2"
 )
 
-func RegisterWebSocketRoutes(router fiber.Router, hub *ws.Hub) {
+func RegisterWebSocketRoutes(router fiber.Router, hub *ws.Hub, kanbanService *service.KanbanServiceImpl, portfolioService svc.PortfolioService) {
+
+    upgrade := func(c *fiber.Ctx) error {
+
+        if websocket.IsWebSocketUpgrade(c) {
+            return c.Next()
+        }
+
+        return fiber.ErrUpgradeRequired
+    }
+
+    // resolveBoard picks the board room to join: the one in the path, or
+    // the project's default board on the legacy project route.
+    resolveBoard := func(c *fiber.Ctx) error {
+        userID, _ := c.Locals("user_id").(string)
+
+        boardID, err := kanbanService.ResolveBoardID(c.Params("projectID"), c.Params("boardID"), userID)
+        if err != nil {
+            if errors.Is(err, models.ErrBoardNotFound) {
+                return fiber.NewError(fiber.StatusNotFound, err.Error())
+            }
+            return fiber.ErrForbidden
+        }
+        c.Locals("board_id", boardID)
+        return c.Next()
+    }
 
     router.Get("/projects/:projectID",
         mw.JWTProtectedWebSocket(),
+        upgrade,
+        resolveBoard,
+        websocket.New(hub.WSHandler),
+    )
+
+    router.Get("/projects/:projectID/boards/:boardID",
+        mw.JWTProtectedWebSocket(),
+        upgrade,
+        resolveBoard,
+        websocket.New(hub.WSHandler),
+    )
+
+    // Portfolio boards relay the events of the projects the viewer can see
+    router.Get("/portfolios/:portfolioID",
+        mw.JWTProtectedWebSocket(),
+        upgrade,
         func(c *fiber.Ctx) error {
+            customerID, _ := c.Locals("customer_id").(string)
+            userID, _ := c.Locals("user_id").(string)
 
-            if websocket.IsWebSocketUpgrade(c) {
-                return c.Next()
+            projectIDs, err := portfolioService.ViewerProjects(context.Background(), customerID, c.Params("portfolioID"), userID)
+            if err != nil {
+                if errors.Is(err, models.ErrPortfolioNotFound) {
+                    return fiber.NewError(fiber.StatusNotFound, err.Error())
+                }
+                return err
             }
-
-            return fiber.ErrUpgradeRequired
+            c.Locals("portfolio_id", c.Params("portfolioID"))
+            c.Locals("portfolio_projects", projectIDs)
+            return c.Next()
         },
-        websocket.New(hub.WSHandler),
+        websocket.New(hub.PortfolioWSHandler),
     )
 }
@@ -1,13 +1,29 @@
 package models
 
 type KanbanBoard struct {
+	Board   *Board                  `json:"board"`
 	Columns []KanbanColumnWithCards `json:"columns"`
+	Sprint  *Sprint                 `json:"sprint,omitempty"` // set in scrum mode
+	// Set when the board is grouped (?swimlanes=assignee|priority|epic|label)
+	GroupBy   string     `json:"group_by,omitempty"`
+	Swimlanes []Swimlane `json:"swimlanes,omitempty"`
+	// Filters applied to the cards, ad-hoc and saved quick filters alike
+	Filters []BoardFilter `json:"filters,omitempty"`
+	// Hidden from Columns, listed so that they can be unarchived
+	ArchivedColumns []KanbanColumn `json:"archived_columns,omitempty"`
 }
 
 type KanbanColumnWithCards struct {
-	ID        string  `json:"id"`
-	ProjectID string  `json:"project_id"`
-	Name      string  `json:"name"`
-	Order     int     `json:"order"`
-	Cards     []Issue `json:"cards"` // issues belonging to this column
+	ID            string  `json:"id"`
+	ProjectID     string  `json:"project_id"`
+	BoardID       string  `json:"board_id"`
+	Name          string  `json:"name"`
+	Order         int     `json:"order"`
+	Rank          string  `json:"rank"`
+	WIPLimit      *int    `json:"wip_limit"`
+	WIPMode       string  `json:"wip_mode"`
+	CardCount     int     `json:"card_count"`     // every live card, even outside the sprint or filters shown
+	FilteredCount int     `json:"filtered_count"` // cards shown
+	OverLimit     bool    `json:"over_limit"`
+	Cards         []Issue `json:"cards"` // issues belonging to this column
 }
@@ -4,7 +4,8 @@ import "time"
 
 type IssueActivity struct {
 	ID        string                 `json:"id"`
-	IssueID   string                 `json:"issue_id"`
+	IssueID   string                 `json:"issue_id,omitempty"` // empty for project events
+	ProjectID *string                `json:"project_id,omitempty"`
 	UserID    *string                `json:"user_id,omitempty"`
 	Action    string                 `json:"action"`
 	Metadata  map[string]interface{} `json:"metadata"`
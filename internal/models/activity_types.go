@@ -9,6 +9,7 @@ package models
 const (
 	ActivityCreated        = "created"
 	ActivityDeleted        = "deleted"
+	ActivityRestored       = "restored"
 
 	// Updated fields
 	ActivityTitleUpdated       = "title_updated"
@@ -18,6 +19,68 @@ const (
 	ActivityAssigned           = "assigned"
 
 	ActivityDueDateUpdated = "due_date_updated"
+
+	// A field was explicitly cleared (null in a merge patch)
+	ActivityFieldCleared = "field_cleared"
+
+	ActivityCustomFieldsUpdated = "custom_fields_updated"
+
+	// One entry per issue of a bulk request; entries share metadata.batch_id
+	ActivityBulkUpdated = "bulk_updated"
+
+	// Cross-project transfer
+	ActivityMovedToProject = "moved_to_project"
+	ActivityCloned         = "cloned"      // on the source issue
+	ActivityClonedFrom     = "cloned_from" // on the new copy
+
+	// Hierarchy
+	ActivityTypeChanged        = "type_changed"
+	ActivityStoryPointsChanged = "story_points_changed"
+	ActivityParentChanged      = "parent_changed" // on the child
+	ActivityChildAdded         = "child_added"    // on the new parent
+	ActivityChildRemoved       = "child_removed"  // on the old parent
+
+	// Sprint membership; nil old/new means the backlog
+	ActivitySprintChanged = "sprint_changed"
+
+	// Release the issue ships in; nil old/new means none
+	ActivityFixVersionChanged = "fix_version_changed"
+
+	// Labels set on a single issue; payload lists added and removed ids
+	ActivityLabelsChanged = "labels_changed"
+
+	// Time tracking; payload names the estimate field, in minutes
+	ActivityEstimateChanged = "estimate_changed"
+	ActivityWorkLogged      = "work_logged"
+	ActivityWorklogUpdated  = "worklog_updated"
+	ActivityWorklogDeleted  = "worklog_deleted"
+)
+
+//
+// ─────────────────────────────────────────────────────────────
+//   KANBAN
+// ─────────────────────────────────────────────────────────────
+//
+
+const (
+	// Card events are logged on the issue
+	ActivityCardCreated = "card_created"
+	ActivityCardMoved   = "card_moved" // column names and order
+	ActivityCardDeleted = "card_deleted"
+
+	// Column events are project events (no issue)
+	ActivityColumnCreated   = "column_created"
+	ActivityColumnRenamed   = "column_renamed"
+	ActivityColumnReordered = "column_reordered"
+	ActivityColumnDeleted   = "column_deleted"
+	ActivityColumnWIPLimitChanged = "column_wip_limit_changed"
+	ActivityColumnArchived        = "column_archived"
+	ActivityColumnUnarchived      = "column_unarchived"
+
+	// Board events are project events too
+	ActivityBoardCreated = "board_created"
+	ActivityBoardUpdated = "board_updated"
+	ActivityBoardDeleted = "board_deleted"
 )
 
 //
@@ -29,6 +92,7 @@ const (
 const (
 	ActivityCommented       = "commented"        // create or update content
 	ActivityCommentDeleted  = "comment_deleted"
+	ActivityCommentRestored = "comment_restored"
 	ActivityMentioned       = "mentioned"        // @mentions
 )
 
@@ -79,4 +143,10 @@ const (
 	ActivitySubtaskCreated = "subtask_created"
 	ActivitySubtaskUpdated = "subtask_updated"
 	ActivitySubtaskDeleted = "subtask_deleted"
+
+	// Conversions; logged on both the parent and the converted item
+	ActivitySubtaskPromoted     = "subtask_promoted"      // on the parent
+	ActivityPromotedFromSubtask = "promoted_from_subtask" // on the new issue
+	ActivityIssueDemoted        = "issue_demoted"         // on the new parent
+	ActivityDemotedToSubtask    = "demoted_to_subtask"    // on the demoted issue
 )
@@ -0,0 +1,206 @@
+package models
+
+import (
+	"strconv"
+	"time"
+)
+
+// Analytics are rebuilt from history: issue_activity_logs (status and
+// sprint changes) and issue_column_moves (kanban columns).
+
+const (
+	AnalyticsUnitPoints = "points"
+	AnalyticsUnitIssues = "issues"
+)
+
+// IssueEvent is one change of a single field. Old and New hold status
+// names, sprint ids or column ids depending on the source.
+type IssueEvent struct {
+	IssueID string
+	Old     *string
+	New     *string
+	At      time.Time
+}
+
+// FlowIssue is the slice of an issue the analytics need. Trashed issues are
+// included so that history stays stable.
+type FlowIssue struct {
+	ID          string
+	Title       string
+	Status      string
+	StoryPoints *int
+	ColumnID    *string
+	SprintID    *string
+	CreatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   BURNDOWN / BURNUP
+// ─────────────────────────────────────────────────────────────
+//
+
+// SprintBurndown has one entry per sprint day. Scope is the sprint content
+// at the end of the day, so burnup reads Scope against Completed and
+// burndown reads Remaining against Ideal. Days still ahead have no values.
+type SprintBurndown struct {
+	SprintID string        `json:"sprint_id"`
+	Unit     string        `json:"unit"`
+	Days     []BurndownDay `json:"days"`
+}
+
+type BurndownDay struct {
+	Date      string  `json:"date"`
+	Ideal     float64 `json:"ideal"`
+	Scope     *int    `json:"scope"`
+	Completed *int    `json:"completed"`
+	Remaining *int    `json:"remaining"`
+}
+
+func (b *SprintBurndown) CSV() [][]string {
+	out := [][]string{{"date", "ideal", "scope", "completed", "remaining"}}
+	for _, d := range b.Days {
+		out = append(out, []string{
+			d.Date, strconv.FormatFloat(d.Ideal, 'f', 2, 64),
+			csvInt(d.Scope), csvInt(d.Completed), csvInt(d.Remaining),
+		})
+	}
+	return out
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   VELOCITY
+// ─────────────────────────────────────────────────────────────
+//
+
+// Velocity covers the last closed sprints, oldest first. Committed is what
+// the sprint held when it was completed.
+type Velocity struct {
+	Sprints          []SprintVelocity `json:"sprints"`
+	AverageCompleted float64          `json:"average_completed"`
+}
+
+type SprintVelocity struct {
+	SprintID        string     `json:"sprint_id"`
+	Name            string     `json:"name"`
+	StartDate       *time.Time `json:"start_date"`
+	CompletedAt     *time.Time `json:"completed_at"`
+	CommittedPoints int        `json:"committed_points"`
+	CompletedPoints int        `json:"completed_points"`
+	CommittedIssues int        `json:"committed_issues"`
+	CompletedIssues int        `json:"completed_issues"`
+}
+
+func (v *Velocity) CSV() [][]string {
+	out := [][]string{{"sprint_id", "name", "completed_at", "committed_points", "completed_points", "committed_issues", "completed_issues"}}
+	for _, s := range v.Sprints {
+		completedAt := ""
+		if s.CompletedAt != nil {
+			completedAt = s.CompletedAt.Format(time.RFC3339)
+		}
+		out = append(out, []string{
+			s.SprintID, s.Name, completedAt,
+			strconv.Itoa(s.CommittedPoints), strconv.Itoa(s.CompletedPoints),
+			strconv.Itoa(s.CommittedIssues), strconv.Itoa(s.CompletedIssues),
+		})
+	}
+	return out
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   CUMULATIVE FLOW
+// ─────────────────────────────────────────────────────────────
+//
+
+// CumulativeFlow counts the cards in each current column at the end of
+// every day of the range.
+type CumulativeFlow struct {
+	From    string       `json:"from"`
+	To      string       `json:"to"`
+	Columns []FlowColumn `json:"columns"`
+	Days    []FlowDay    `json:"days"`
+}
+
+type FlowColumn struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type FlowDay struct {
+	Date   string         `json:"date"`
+	Counts map[string]int `json:"counts"` // by column id
+}
+
+func (f *CumulativeFlow) CSV() [][]string {
+	header := []string{"date"}
+	for _, c := range f.Columns {
+		header = append(header, c.Name)
+	}
+	out := [][]string{header}
+	for _, d := range f.Days {
+		row := []string{d.Date}
+		for _, c := range f.Columns {
+			row = append(row, strconv.Itoa(d.Counts[c.ID]))
+		}
+		out = append(out, row)
+	}
+	return out
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   LEAD / CYCLE TIME
+// ─────────────────────────────────────────────────────────────
+//
+
+// FlowTimes covers issues resolved in the range. Lead time runs from
+// creation to resolution, cycle time from the first sign of work (leaving
+// "open" or the first column move) to resolution. Durations are in hours.
+type FlowTimes struct {
+	From   string          `json:"from"`
+	To     string          `json:"to"`
+	Lead   Distribution    `json:"lead_time"`
+	Cycle  Distribution    `json:"cycle_time"`
+	Issues []IssueFlowTime `json:"issues"`
+}
+
+type Distribution struct {
+	Count int     `json:"count"`
+	Mean  float64 `json:"mean"`
+	P50   float64 `json:"p50"`
+	P85   float64 `json:"p85"`
+	P95   float64 `json:"p95"`
+}
+
+type IssueFlowTime struct {
+	IssueID    string    `json:"issue_id"`
+	Title      string    `json:"title"`
+	ResolvedAt time.Time `json:"resolved_at"`
+	LeadHours  float64   `json:"lead_hours"`
+	CycleHours *float64  `json:"cycle_hours"`
+}
+
+func (f *FlowTimes) CSV() [][]string {
+	out := [][]string{{"issue_id", "title", "resolved_at", "lead_hours", "cycle_hours"}}
+	for _, i := range f.Issues {
+		cycle := ""
+		if i.CycleHours != nil {
+			cycle = strconv.FormatFloat(*i.CycleHours, 'f', 2, 64)
+		}
+		out = append(out, []string{
+			i.IssueID, i.Title, i.ResolvedAt.Format(time.RFC3339),
+			strconv.FormatFloat(i.LeadHours, 'f', 2, 64), cycle,
+		})
+	}
+	return out
+}
+
+func csvInt(v *int) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrBoardNotFound      = errors.New("board not found")
+	ErrDefaultBoardDelete = errors.New("the default board cannot be deleted")
+	ErrBoardNotEmpty      = errors.New("board still has cards; move or delete them first")
+	ErrDefaultBoardFilter = errors.New("the default board shows every card and cannot be filtered")
+)
+
+// Board is one kanban board of a project with its own columns. Filter
+// decides which cards of the project the board shows, so teams sharing a
+// project can keep e.g. label = support on a board of their own; cards
+// whose column is on another board show up in the column of the same name.
+// Each project has one default board, used by the project-level routes. It
+// takes no filter, so every card is on at least one board.
+type Board struct {
+	ID        string      `json:"id"`
+	ProjectID string      `json:"project_id"`
+	Name      string      `json:"name"`
+	Filter    BoardFilter `json:"filter"`
+	IsDefault bool        `json:"is_default"`
+	CreatedBy *string     `json:"created_by,omitempty"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
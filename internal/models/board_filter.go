@@ -0,0 +1,42 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrQuickFilterNotFound = errors.New("quick filter not found")
+	ErrInvalidBoardFilter  = errors.New("invalid board filter")
+)
+
+// BoardFilter narrows the cards shown on a board. Lists match any of their
+// values; the fields are combined with AND. Due dates are YYYY-MM-DD and
+// inclusive. The column card counts always cover the unfiltered board.
+type BoardFilter struct {
+	AssigneeIDs       []string `json:"assignee_ids,omitempty"`
+	LabelIDs          []string `json:"label_ids,omitempty"`
+	Priorities        []string `json:"priorities,omitempty"`
+	Text              string   `json:"text,omitempty"`
+	DueFrom           string   `json:"due_from,omitempty"`
+	DueTo             string   `json:"due_to,omitempty"`
+	OnlyMine          bool     `json:"only_mine,omitempty"` // assigned to whoever views the board
+	UpdatedWithinDays int      `json:"updated_within_days,omitempty"`
+}
+
+func (f *BoardFilter) IsEmpty() bool {
+	return len(f.AssigneeIDs) == 0 && len(f.LabelIDs) == 0 && len(f.Priorities) == 0 &&
+		f.Text == "" && f.DueFrom == "" && f.DueTo == "" && !f.OnlyMine && f.UpdatedWithinDays == 0
+}
+
+// QuickFilter is a named BoardFilter saved on a project. Several quick
+// filters can be applied to a board at once; they are combined with AND.
+type QuickFilter struct {
+	ID        string      `json:"id"`
+	ProjectID string      `json:"project_id"`
+	Name      string      `json:"name"`
+	Filter    BoardFilter `json:"filter"`
+	CreatedBy string      `json:"created_by"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
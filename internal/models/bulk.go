@@ -0,0 +1,66 @@
+package models
+
+import "time"
+
+// MaxBulkIssues caps how many issues one bulk request may touch.
+const MaxBulkIssues = 500
+
+// BulkIssueRequest selects issues either by ID or by the same filters the
+// project issue list accepts, and describes the change to apply to them.
+type BulkIssueRequest struct {
+	IssueIDs []string        `json:"issue_ids"`
+	Query    *BulkIssueQuery `json:"query"`
+	Changes  BulkIssueChange `json:"changes"`
+}
+
+type BulkIssueQuery struct {
+	Status     *string `json:"status"`
+	Priority   *string `json:"priority"`
+	AssignedTo *string `json:"assigned_to"`
+	Search     *string `json:"search"`
+}
+
+// BulkIssueChange lists the edits to apply; any combination may be given,
+// except Delete which must come alone. AssignedTo and DueDate follow merge
+// patch rules (null clears).
+type BulkIssueChange struct {
+	Status       *string               `json:"status"`
+	Priority     *string               `json:"priority"`
+	AssignedTo   PatchField[string]    `json:"assigned_to"`
+	DueDate      PatchField[time.Time] `json:"due_date"`
+	AddLabels    []string              `json:"add_labels"`
+	RemoveLabels []string              `json:"remove_labels"`
+	ColumnID     *string               `json:"column_id"`
+	Delete       bool                  `json:"delete"`
+}
+
+func (c BulkIssueChange) Empty() bool {
+	return c.Status == nil && c.Priority == nil && !c.AssignedTo.Set && !c.DueDate.Set &&
+		len(c.AddLabels) == 0 && len(c.RemoveLabels) == 0 && c.ColumnID == nil && !c.Delete
+}
+
+type FieldChange struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// BulkItemResult is the outcome for one issue of a bulk request.
+type BulkItemResult struct {
+	IssueID string                 `json:"issue_id"`
+	OK      bool                   `json:"ok"`
+	Error   string                 `json:"error,omitempty"`
+	Changes map[string]FieldChange `json:"changes,omitempty"`
+	// set when a column move went past a soft WIP limit
+	WIPWarning *WIPWarning `json:"wip_warning,omitempty"`
+
+	Title     string   `json:"-"`
+	Assignees []string `json:"-"` // previous and new assignee, for notifications
+}
+
+type BulkResult struct {
+	BatchID   string           `json:"batch_id"`
+	Total     int              `json:"total"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Results   []BulkItemResult `json:"results"`
+}
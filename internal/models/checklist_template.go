@@ -0,0 +1,33 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrChecklistTemplateNotFound = errors.New("checklist template not found")
+
+// ChecklistTemplate is a named, project-scoped checklist. Title and Items
+// are those of the current version.
+type ChecklistTemplate struct {
+	ID         string    `json:"id"`
+	CustomerID string    `json:"customer_id"`
+	ProjectID  string    `json:"project_id"`
+	Name       string    `json:"name"`
+	Version    int       `json:"version"`
+	Title      string    `json:"title"`
+	Items      []string  `json:"items"`
+	CreatedBy  string    `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// ChecklistTemplateVersion is an immutable snapshot of a template.
+type ChecklistTemplateVersion struct {
+	TemplateID string    `json:"template_id"`
+	Version    int       `json:"version"`
+	Title      string    `json:"title"`
+	Items      []string  `json:"items"`
+	CreatedBy  string    `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -10,6 +10,9 @@ type IssueComment struct {
     BodyHTML    *string    `json:"body_html"`
     CreatedAt   time.Time  `json:"created_at"`
     UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+    Version     int        `json:"version"`
+    DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+    DeletedBy   *string    `json:"deleted_by,omitempty"`
 
     AuthorName  *string    `json:"author_name,omitempty"`
     AuthorEmail *string    `json:"author_email,omitempty"`
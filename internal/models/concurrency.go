@@ -0,0 +1,17 @@
+package models
+
+import "errors"
+
+var ErrVersionConflict = errors.New("version conflict: the resource was modified by someone else")
+
+// VersionConflictError is returned when an update carried an expected
+// version that no longer matches. Current holds the server's copy so the
+// client can merge and retry.
+type VersionConflictError struct {
+	Version int
+	Current interface{}
+}
+
+func (e *VersionConflictError) Error() string { return ErrVersionConflict.Error() }
+
+func (e *VersionConflictError) Unwrap() error { return ErrVersionConflict }
@@ -0,0 +1,49 @@
+package models
+
+import "errors"
+
+// Issue types, from the top of the hierarchy down.
+const (
+	IssueTypeEpic  = "epic"
+	IssueTypeStory = "story"
+	IssueTypeTask  = "task"
+	IssueTypeBug   = "bug"
+)
+
+var ErrIssueCycle = errors.New("parent issue would create a cycle")
+
+// IssueTreeNode is an issue together with its live descendants.
+type IssueTreeNode struct {
+	Issue
+	Children []*IssueTreeNode `json:"children"`
+}
+
+// IssueTree is the response of GET /issues/:id/tree. Ancestors run from
+// the direct parent up to the root.
+type IssueTree struct {
+	Ancestors []Issue        `json:"ancestors"`
+	Root      *IssueTreeNode `json:"root"`
+}
+
+// IssueRollup aggregates every descendant of an issue (usually an epic).
+// Percent is weighted by story points when any descendant has them, and by
+// issue count otherwise.
+type IssueRollup struct {
+	IssueID         string         `json:"issue_id"`
+	Total           int            `json:"total"`
+	Done            int            `json:"done"`
+	ByStatus        map[string]int `json:"by_status"`
+	ByType          map[string]int `json:"by_type"`
+	StoryPoints     int            `json:"story_points"`
+	StoryPointsDone int            `json:"story_points_done"`
+	Percent         int            `json:"percent"`
+	Time            IssueTimeRollup `json:"time"`
+}
+
+// IssueTimeRollup sums estimates and logged time, in minutes, over an issue
+// and all of its descendants.
+type IssueTimeRollup struct {
+	OriginalEstimate  int `json:"original_estimate_minutes"`
+	RemainingEstimate int `json:"remaining_estimate_minutes"`
+	TimeSpent         int `json:"time_spent_minutes"`
+}
@@ -6,16 +6,32 @@ type Issue struct {
 	ID          string     `json:"id"`
 	ProjectID   string     `json:"project_id"`
 	ColumnID    string     `json:"column_id"`
-  Order       int        `json:"order"`
+  Order       int        `json:"order"` // position in the column, derived from Rank
+	Rank        string     `json:"rank,omitempty"`
 	Title       string     `json:"title"`
 	Description string     `json:"description"`
 	Status      string     `json:"status"`
 	Priority    string     `json:"priority"`
+	IssueType   string     `json:"issue_type"`
+	ParentIssueID *string  `json:"parent_issue_id,omitempty"`
+	StoryPoints *int       `json:"story_points,omitempty"`
+	OriginalEstimate  *int `json:"original_estimate_minutes,omitempty"`
+	RemainingEstimate *int `json:"remaining_estimate_minutes,omitempty"`
+	TimeSpent   *int       `json:"time_spent_minutes,omitempty"`
+	SprintID    *string    `json:"sprint_id,omitempty"`
+	BacklogOrder *int      `json:"backlog_order,omitempty"`
+	FixVersionID *string   `json:"fix_version_id,omitempty"`
 	CreatedBy   string     `json:"created_by"`
 	AssignedTo  *string    `json:"assigned_to,omitempty"`
   DueDate     *time.Time `json:"due_date,omitempty"`
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+	Version     int        `json:"version"`
+	MovedFromProjectID *string `json:"moved_from_project_id,omitempty"`
+	Progress    *IssueProgress `json:"progress,omitempty"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	DeletedBy   *string    `json:"deleted_by,omitempty"`
 }
 
 type IssueWithUser struct {
@@ -25,6 +41,11 @@ type IssueWithUser struct {
 	Description     string     `json:"description"`
 	Status          string     `json:"status"`
 	Priority        string     `json:"priority"`
+	IssueType       string     `json:"issue_type"`
+	ParentIssueID   *string    `json:"parent_issue_id"`
+	StoryPoints     *int       `json:"story_points"`
+	SprintID        *string    `json:"sprint_id"`
+	FixVersionID    *string    `json:"fix_version_id"`
   DueDate     *time.Time `json:"due_date"`
 
 	CreatedBy       string     `json:"created_by"`
@@ -35,6 +56,8 @@ type IssueWithUser struct {
 	AssignedToEmail *string    `json:"assigned_to_email"`
 	AssignedToName  *string    `json:"assigned_to_name"`
 
+	Progress        *IssueProgress `json:"progress,omitempty"`
+
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
 }
@@ -55,6 +78,8 @@ type Checklist struct {
     ID        string          `json:"id"`
     IssueID   string          `json:"issue_id"`
     Title     string          `json:"title"`
+    TemplateID      *string   `json:"template_id,omitempty"`
+    TemplateVersion *int      `json:"template_version,omitempty"`
     CreatedAt time.Time       `json:"created_at"`
     Items     []ChecklistItem `json:"items"` // NEW FIELD
 }
@@ -65,6 +90,7 @@ type ChecklistItem struct {
   Content    string  `json:"content"`
   Done       bool    `json:"done"`
   OrderIndex int     `json:"order_index"`
+  Version    int     `json:"version"`
 }
 
 type Subtask struct {
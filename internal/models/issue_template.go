@@ -0,0 +1,37 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrTemplateNotFound = errors.New("template not found")
+
+// IssueTemplate pre-fills a new issue. Everything except Name is optional.
+type IssueTemplate struct {
+	ID           string                 `json:"id"`
+	CustomerID   string                 `json:"customer_id"`
+	ProjectID    string                 `json:"project_id"`
+	Name         string                 `json:"name"`
+	TitlePrefix  string                 `json:"title_prefix"`
+	Description  string                 `json:"description"`
+	Priority     *string                `json:"priority"`
+	AssignedTo   *string                `json:"assigned_to"`
+	LabelIDs     []string               `json:"label_ids"`
+	Checklists   []TemplateChecklist    `json:"checklists"`
+	Subtasks     []TemplateSubtask      `json:"subtasks"`
+	CustomFields map[string]interface{} `json:"custom_fields"`
+	CreatedBy    string                 `json:"created_by"`
+	CreatedAt    time.Time              `json:"created_at"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+}
+
+type TemplateChecklist struct {
+	Title string   `json:"title"`
+	Items []string `json:"items"`
+}
+
+type TemplateSubtask struct {
+	Title       string  `json:"title"`
+	Description *string `json:"description,omitempty"`
+}
@@ -1,12 +1,47 @@
 package models
 
-import "time"
+import (
+	"errors"
+	"time"
+)
 
 type KanbanColumn struct {
     ID        string    `json:"id" db:"id"`
     ProjectID string    `json:"project_id" db:"project_id"`
+    BoardID   string    `json:"board_id" db:"board_id"`
     Name      string    `json:"name" db:"name"`
-    Order     int       `json:"order" db:"order"`
+    Order     int       `json:"order" db:"order"` // position, derived from Rank
+    Rank      string    `json:"rank" db:"rank"`
+    WIPLimit  *int      `json:"wip_limit" db:"wip_limit"` // nil = unlimited
+    WIPMode   string    `json:"wip_mode" db:"wip_mode"`
+    // Archived columns are hidden from the board; their cards stay put
+    ArchivedAt *time.Time `json:"archived_at,omitempty" db:"archived_at"`
     CreatedAt time.Time `json:"created_at" db:"created_at"`
     UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
+
+const (
+    WIPModeSoft = "soft" // allow, but warn over the hub
+    WIPModeHard = "hard" // reject
+)
+
+var ErrWIPLimitExceeded = errors.New("wip_limit_exceeded")
+
+var (
+    ErrInvalidWIPLimit = errors.New("wip_limit must be positive")
+    ErrInvalidWIPMode  = errors.New("wip_mode must be soft or hard")
+)
+
+var (
+    ErrColumnArchived       = errors.New("column_archived")
+    ErrColumnTargetRequired = errors.New("column has cards, a target column to move them into is required")
+)
+
+// WIPWarning reports a card let into a soft-limited column that is now
+// over its limit.
+type WIPWarning struct {
+    ColumnID   string `json:"column_id"`
+    ColumnName string `json:"column_name"`
+    Limit      int    `json:"limit"`
+    Count      int    `json:"count"`
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor points at one row of a keyset-paginated list. It is handed to
+// clients as an opaque base64 string (see EncodeCursor).
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+	Backward  bool // page towards the start of the list instead of the end
+}
+
+type cursorWire struct {
+	T  int64  `json:"t"`
+	ID string `json:"id"`
+	B  bool   `json:"b,omitempty"`
+}
+
+func EncodeCursor(c Cursor) string {
+	b, _ := json.Marshal(cursorWire{T: c.CreatedAt.UnixMicro(), ID: c.ID, B: c.Backward})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeCursor(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var w cursorWire
+	if err := json.Unmarshal(raw, &w); err != nil || w.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return &Cursor{
+		CreatedAt: time.UnixMicro(w.T).UTC(),
+		ID:        w.ID,
+		Backward:  w.B,
+	}, nil
+}
+
+// PageRequest is what list endpoints accept: an optional cursor, the page
+// size and whether the (more expensive) total count should be computed.
+type PageRequest struct {
+	Cursor    *Cursor
+	Limit     int
+	WithTotal bool
+}
+
+type PageInfo struct {
+	Limit      int     `json:"limit"`
+	NextCursor *string `json:"next_cursor"`
+	PrevCursor *string `json:"prev_cursor"`
+	Total      *int    `json:"total,omitempty"`
+}
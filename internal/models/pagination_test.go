@@ -0,0 +1,49 @@
+package models
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	at := time.Date(2024, 3, 9, 14, 30, 5, 123456789, time.FixedZone("CET", 3600))
+
+	for _, backward := range []bool{false, true} {
+		in := Cursor{CreatedAt: at, ID: "6f1c2d3e-0000-4000-8000-000000000001", Backward: backward}
+
+		got, err := DecodeCursor(EncodeCursor(in))
+		if err != nil {
+			t.Fatalf("DecodeCursor(EncodeCursor(%+v)): %v", in, err)
+		}
+		// Postgres keeps microseconds, so that is all the cursor carries.
+		if want := at.Truncate(time.Microsecond); !got.CreatedAt.Equal(want) {
+			t.Errorf("CreatedAt = %v, want %v", got.CreatedAt, want)
+		}
+		if got.ID != in.ID || got.Backward != in.Backward {
+			t.Errorf("cursor = %+v, want %+v", *got, in)
+		}
+	}
+}
+
+func TestDecodeCursorRejectsGarbage(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{name: "empty", in: ""},
+		{name: "not base64", in: "%%%"},
+		{name: "not json", in: base64.RawURLEncoding.EncodeToString([]byte("hello"))},
+		{name: "no id", in: base64.RawURLEncoding.EncodeToString([]byte(`{"t":1}`))},
+		{name: "padded", in: base64.URLEncoding.EncodeToString([]byte(`{"t":1,"id":"x"}`))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeCursor(tt.in); !errors.Is(err, ErrInvalidCursor) {
+				t.Fatalf("DecodeCursor(%q) error = %v, want %v", tt.in, err, ErrInvalidCursor)
+			}
+		})
+	}
+}
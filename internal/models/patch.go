@@ -0,0 +1,64 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// PatchField is one member of an RFC 7396 JSON merge patch. Keys that are
+// absent from the document leave Set false; an explicit null sets Null.
+type PatchField[T any] struct {
+	Set   bool
+	Null  bool
+	Value T
+}
+
+func (f *PatchField[T]) UnmarshalJSON(b []byte) error {
+	f.Set = true
+	if string(b) == "null" {
+		f.Null = true
+		return nil
+	}
+	return json.Unmarshal(b, &f.Value)
+}
+
+// IssuePatch is the body of PATCH /issues/:id. Absent fields are left
+// unchanged and null clears a field. custom_fields is merged key by key
+// (a null value removes that key).
+type IssuePatch struct {
+	Title             PatchField[string]                 `json:"title"`
+	Description       PatchField[string]                 `json:"description"`
+	Status            PatchField[string]                 `json:"status"`
+	Priority          PatchField[string]                 `json:"priority"`
+	AssignedTo        PatchField[string]                 `json:"assigned_to"`
+	DueDate           PatchField[time.Time]              `json:"due_date"`
+	IssueType         PatchField[string]                 `json:"issue_type"`
+	ParentIssueID     PatchField[string]                 `json:"parent_issue_id"`
+	StoryPoints       PatchField[int]                    `json:"story_points"`
+	OriginalEstimate  PatchField[int]                    `json:"original_estimate_minutes"`
+	RemainingEstimate PatchField[int]                    `json:"remaining_estimate_minutes"`
+	CustomFields      PatchField[map[string]interface{}] `json:"custom_fields"`
+}
+
+// MergePatch applies an RFC 7396 patch to target, returning the result.
+func MergePatch(target map[string]interface{}, patch map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(target)+len(patch))
+	for k, v := range target {
+		out[k] = v
+	}
+
+	for k, v := range patch {
+		if v == nil {
+			delete(out, k)
+			continue
+		}
+		if sub, ok := v.(map[string]interface{}); ok {
+			cur, _ := out[k].(map[string]interface{})
+			out[k] = MergePatch(cur, sub)
+			continue
+		}
+		out[k] = v
+	}
+
+	return out
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrPortfolioNotFound  = errors.New("portfolio not found")
+	ErrPortfolioNoMapping = errors.New("the card's project has no column mapped to this portfolio column")
+)
+
+// Portfolio is a board spanning several projects of a customer. Its
+// columns gather the cards of the project columns mapped into them.
+type Portfolio struct {
+	ID         string            `json:"id"`
+	CustomerID string            `json:"customer_id"`
+	Name       string            `json:"name"`
+	ProjectIDs []string          `json:"project_ids"`
+	Columns    []PortfolioColumn `json:"columns"`
+	CreatedBy  string            `json:"created_by"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// PortfolioColumn lists the project kanban columns mapped into it. A
+// project column maps into at most one column of a portfolio.
+type PortfolioColumn struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Order     int      `json:"order"` // position, derived from Rank
+	Rank      string   `json:"rank"`
+	ColumnIDs []string `json:"column_ids"`
+}
+
+// PortfolioBoard is a portfolio with its cards. Only the projects the
+// viewer is a member of are shown.
+type PortfolioBoard struct {
+	Portfolio *Portfolio                 `json:"portfolio"`
+	Projects  []PortfolioProject         `json:"projects"`
+	Columns   []PortfolioColumnWithCards `json:"columns"`
+}
+
+// PortfolioProject identifies a project on a portfolio board; Key is the
+// project slug shown on its cards.
+type PortfolioProject struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+type PortfolioColumnWithCards struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	Order     int             `json:"order"`
+	CardCount int             `json:"card_count"`
+	Cards     []PortfolioCard `json:"cards"` // by project, then project column and position
+}
+
+// PortfolioCard is a kanban card as seen on a portfolio board.
+type PortfolioCard struct {
+	Issue
+	ProjectKey  string `json:"project_key"`
+	ProjectName string `json:"project_name"`
+	ColumnName  string `json:"column_name"` // the project column holding the card
+}
@@ -0,0 +1,40 @@
+package models
+
+// SubtaskDoneStatuses are the subtask statuses counted as finished.
+var SubtaskDoneStatuses = []string{"done", "closed", "resolved", "completed"}
+
+// IssueDoneStatuses are the issue statuses counted as finished.
+var IssueDoneStatuses = []string{"resolved", "closed"}
+
+// IsIssueDone reports whether status is one of IssueDoneStatuses.
+func IsIssueDone(status string) bool {
+	for _, done := range IssueDoneStatuses {
+		if status == done {
+			return true
+		}
+	}
+	return false
+}
+
+// IssueProgress rolls up checklist items and subtasks of an issue.
+// Percent weighs every checklist item and subtask equally.
+type IssueProgress struct {
+	ChecklistDone  int `json:"checklist_done"`
+	ChecklistTotal int `json:"checklist_total"`
+	SubtasksDone   int `json:"subtasks_done"`
+	SubtasksTotal  int `json:"subtasks_total"`
+	Percent        int `json:"percent"`
+}
+
+func NewIssueProgress(checklistDone, checklistTotal, subtasksDone, subtasksTotal int) *IssueProgress {
+	p := &IssueProgress{
+		ChecklistDone:  checklistDone,
+		ChecklistTotal: checklistTotal,
+		SubtasksDone:   subtasksDone,
+		SubtasksTotal:  subtasksTotal,
+	}
+	if total := checklistTotal + subtasksTotal; total > 0 {
+		p.Percent = (checklistDone + subtasksDone) * 100 / total
+	}
+	return p
+}
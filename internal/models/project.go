@@ -1,6 +1,11 @@
 package models
 
-import "time"
+import (
+    "errors"
+    "time"
+)
+
+var ErrProjectNotFound = errors.New("project not found")
 
 type Project struct {
     ID         string    `json:"id" db:"id"`
@@ -9,4 +14,6 @@ type Project struct {
     Slug       string    `json:"slug" db:"slug"` // used for subdomain/route
     CreatedAt  time.Time `json:"created_at" db:"created_at"`
     UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+    DeletedAt  *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+    DeletedBy  *string    `json:"deleted_by,omitempty" db:"deleted_by"`
 }
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"errors"
+	"strings"
+)
+
+// Kanban cards and columns are ordered by lexicographic ranks: strings of
+// base-36 digits compared bytewise (the rank columns use COLLATE "C").
+// There is always room between two distinct ranks, so a move only writes
+// the moved row. Ranks grow when the same gap is split again and again;
+// the RankRebalancer respaces a column once its ranks pass RankMaxLength.
+
+const (
+	rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+	rankBase   = len(rankDigits)
+
+	// RankMaxLength is the rank length that triggers a rebalance.
+	RankMaxLength = 12
+)
+
+// ErrRankCollision is returned when no rank fits between two neighbours,
+// e.g. two concurrent moves picked the same rank. Rebalancing the column
+// and asking again always succeeds.
+var ErrRankCollision = errors.New("rank_collision")
+
+// RankBetween returns a rank strictly between a and b. An empty a means
+// the start of the list, an empty b its end.
+func RankBetween(a, b string) (string, error) {
+	if b != "" && a >= b {
+		return "", ErrRankCollision
+	}
+
+	out := make([]byte, 0, len(a)+1)
+	bounded := b != ""
+	for i := 0; ; i++ {
+		lo := 0
+		if i < len(a) {
+			lo = rankDigit(a[i])
+		}
+		hi := rankBase
+		if bounded {
+			if i >= len(b) {
+				return "", ErrRankCollision
+			}
+			hi = rankDigit(b[i])
+		}
+
+		if hi-lo > 1 {
+			return string(append(out, rankDigits[(lo+hi)/2])), nil
+		}
+		out = append(out, rankDigits[lo])
+		if hi-lo == 1 {
+			// The prefix is now below b; only a constrains the rest.
+			bounded = false
+		}
+	}
+}
+
+// RankAfter returns a short rank past a, for appending to a list. It bumps
+// the first digit that can be bumped, so repeated appends stay short.
+func RankAfter(a string) string {
+	for i := 0; i < len(a); i++ {
+		if d := rankDigit(a[i]); d < rankBase-1 {
+			return a[:i] + string(rankDigits[d+1])
+		}
+	}
+	r, _ := RankBetween(a, "")
+	return r
+}
+
+// RankSequence returns n evenly spaced ranks of equal length, used to
+// seed a list or to rebalance it.
+func RankSequence(n int) []string {
+	width, space := 1, rankBase
+	for space/(n+1) < rankBase {
+		width++
+		space *= rankBase
+	}
+	step := space / (n + 1)
+
+	out := make([]string, n)
+	for i := range out {
+		out[i] = rankString((i+1)*step, width)
+	}
+	return out
+}
+
+func rankString(v, width int) string {
+	b := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		b[i] = rankDigits[v%rankBase]
+		v /= rankBase
+	}
+	return string(b)
+}
+
+func rankDigit(c byte) int {
+	if d := strings.IndexByte(rankDigits, c); d >= 0 {
+		return d
+	}
+	return 0
+}
@@ -0,0 +1,123 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		want    string
+		wantErr error
+	}{
+		{name: "empty list", a: "", b: "", want: "i"},
+		{name: "before first", a: "", b: "1", want: "0i"},
+		{name: "after last", a: "5", b: "", want: "k"},
+		{name: "wide gap", a: "a", b: "c", want: "b"},
+		{name: "adjacent digits", a: "0", b: "1", want: "0i"},
+		{name: "z tail", a: "z", b: "", want: "zi"},
+		{name: "long z tail", a: "zz", b: "", want: "zzi"},
+		{name: "z before bound", a: "az", b: "b", want: "azi"},
+		{name: "prefix of a", a: "1", b: "1i", want: "19"},
+		{name: "1 vs 10", a: "1", b: "10", wantErr: ErrRankCollision},
+		{name: "equal", a: "a", b: "a", wantErr: ErrRankCollision},
+		{name: "reversed", a: "b", b: "a", wantErr: ErrRankCollision},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RankBetween(tt.a, tt.b)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("RankBetween(%q, %q) error = %v, want %v", tt.a, tt.b, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RankBetween(%q, %q) unexpected error: %v", tt.a, tt.b, err)
+			}
+			if got != tt.want {
+				t.Errorf("RankBetween(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+			}
+			if got <= tt.a || (tt.b != "" && got >= tt.b) {
+				t.Errorf("RankBetween(%q, %q) = %q, not strictly between", tt.a, tt.b, got)
+			}
+		})
+	}
+}
+
+func TestRankBetweenRepeatedSplit(t *testing.T) {
+	// Dropping cards into the same gap again and again must keep finding
+	// room, from both sides.
+	lo, hi := "a", "b"
+	for i := 0; i < 200; i++ {
+		mid, err := RankBetween(lo, hi)
+		if err != nil {
+			t.Fatalf("split %d: RankBetween(%q, %q): %v", i, lo, hi, err)
+		}
+		if mid <= lo || mid >= hi {
+			t.Fatalf("split %d: %q not between %q and %q", i, mid, lo, hi)
+		}
+		if i%2 == 0 {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+}
+
+func TestRankAfter(t *testing.T) {
+	tests := []struct {
+		a, want string
+	}{
+		{a: "", want: "i"},
+		{a: "1", want: "2"},
+		{a: "10", want: "2"},
+		{a: "a", want: "b"},
+		{a: "y", want: "z"},
+		{a: "z", want: "zi"},
+		{a: "z5", want: "z6"},
+		{a: "zz", want: "zzi"},
+	}
+
+	for _, tt := range tests {
+		got := RankAfter(tt.a)
+		if got != tt.want {
+			t.Errorf("RankAfter(%q) = %q, want %q", tt.a, got, tt.want)
+		}
+		if got <= tt.a {
+			t.Errorf("RankAfter(%q) = %q, not after it", tt.a, got)
+		}
+	}
+}
+
+func TestRankSequence(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 35, 36, 100, 1296, 5000} {
+		got := RankSequence(n)
+		if len(got) != n {
+			t.Fatalf("RankSequence(%d) returned %d ranks", n, len(got))
+		}
+		for i, r := range got {
+			if len(r) != len(got[0]) {
+				t.Fatalf("RankSequence(%d)[%d] = %q, width differs from %q", n, i, r, got[0])
+			}
+			if i > 0 && r <= got[i-1] {
+				t.Fatalf("RankSequence(%d)[%d] = %q, not after %q", n, i, r, got[i-1])
+			}
+		}
+		if n == 0 {
+			continue
+		}
+		// A respaced list leaves room at both ends and between neighbours.
+		if _, err := RankBetween("", got[0]); err != nil {
+			t.Errorf("RankSequence(%d): no room before %q: %v", n, got[0], err)
+		}
+		if len(got) > 1 {
+			if _, err := RankBetween(got[0], got[1]); err != nil {
+				t.Errorf("RankSequence(%d): no room between %q and %q: %v", n, got[0], got[1], err)
+			}
+		}
+	}
+}
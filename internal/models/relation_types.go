@@ -0,0 +1,12 @@
+package models
+
+// Relation types written by the server itself. Users may create relations
+// of any other type through AddRelation.
+const (
+	// On a clone, pointing at the issue it was cloned from.
+	RelationClonedFrom = "cloned_from"
+
+	// Between a promoted subtask and its former parent issue.
+	RelationSubtaskOf = "subtask_of" // promoted issue -> parent
+	RelationParentOf  = "parent_of"  // parent -> promoted issue
+)
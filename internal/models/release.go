@@ -0,0 +1,72 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrReleaseNotFound = errors.New("release not found")
+
+type Release struct {
+	ID          string           `json:"id"`
+	CustomerID  string           `json:"customer_id"`
+	ProjectID   string           `json:"project_id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	TargetDate  *time.Time       `json:"target_date"`
+	Released    bool             `json:"released"`
+	ReleasedAt  *time.Time       `json:"released_at,omitempty"`
+	Progress    *ReleaseProgress `json:"progress,omitempty"`
+	CreatedBy   string           `json:"created_by"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// ReleaseProgress counts the issues whose fix version is the release.
+type ReleaseProgress struct {
+	Total           int `json:"total"`
+	Done            int `json:"done"`
+	StoryPoints     int `json:"story_points"`
+	StoryPointsDone int `json:"story_points_done"`
+	Percent         int `json:"percent"`
+}
+
+func NewReleaseProgress(total, done, points, pointsDone int) *ReleaseProgress {
+	p := &ReleaseProgress{
+		Total:           total,
+		Done:            done,
+		StoryPoints:     points,
+		StoryPointsDone: pointsDone,
+	}
+	if total > 0 {
+		p.Percent = done * 100 / total
+	}
+	return p
+}
+
+// ChangelogEntry is a finished issue of a release.
+type ChangelogEntry struct {
+	IssueID   string    `json:"issue_id"`
+	Title     string    `json:"title"`
+	IssueType string    `json:"issue_type"`
+	Labels    []string  `json:"labels"` // label names, sorted
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Changelog groups the finished issues of a release by issue type, then by
+// label. An issue is listed under its first label only; its other labels
+// stay in Labels.
+type Changelog struct {
+	Release  *Release           `json:"release"`
+	Sections []ChangelogSection `json:"sections"`
+}
+
+type ChangelogSection struct {
+	IssueType string           `json:"issue_type"`
+	Groups    []ChangelogGroup `json:"groups"`
+}
+
+type ChangelogGroup struct {
+	Label   string           `json:"label"` // "" for unlabelled issues
+	Entries []ChangelogEntry `json:"entries"`
+}
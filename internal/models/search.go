@@ -0,0 +1,32 @@
+package models
+
+import "errors"
+
+// Result types returned by the global search endpoint.
+const (
+	SearchTypeIssue   = "issue"
+	SearchTypeComment = "comment"
+	SearchTypeProject = "project"
+	SearchTypeUser    = "user"
+)
+
+// ErrInvalidSearch is wrapped by every error about the search request
+// itself (empty query, unknown type, unknown project).
+var ErrInvalidSearch = errors.New("invalid search")
+
+type SearchQuery struct {
+	Text      string
+	Types     []string
+	ProjectID *string
+	Limit     int
+}
+
+type SearchResult struct {
+	Type      string  `json:"type"`
+	ID        string  `json:"id"`
+	ProjectID *string `json:"project_id,omitempty"`
+	IssueID   *string `json:"issue_id,omitempty"`
+	Title     string  `json:"title"`
+	Snippet   string  `json:"snippet"`
+	Rank      float64 `json:"rank"`
+}
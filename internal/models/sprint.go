@@ -0,0 +1,68 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrSprintNotFound = errors.New("sprint not found")
+
+const (
+	SprintPlanned = "planned"
+	SprintActive  = "active"
+	SprintClosed  = "closed"
+)
+
+// DefaultSprintLength is used for the end date when a sprint is started
+// without one.
+const DefaultSprintLength = 14 * 24 * time.Hour
+
+type Sprint struct {
+	ID          string        `json:"id"`
+	CustomerID  string        `json:"customer_id"`
+	ProjectID   string        `json:"project_id"`
+	Name        string        `json:"name"`
+	Goal        string        `json:"goal"`
+	StartDate   *time.Time    `json:"start_date"`
+	EndDate     *time.Time    `json:"end_date"`
+	State       string        `json:"state"`
+	Report      *SprintReport `json:"report,omitempty"`
+	CreatedBy   string        `json:"created_by"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+	CompletedAt *time.Time    `json:"completed_at,omitempty"`
+}
+
+// CompleteSprintRequest chooses where unfinished issues go. Without
+// NextSprintID they roll into the earliest planned sprint, or back to the
+// backlog when there is none or ToBacklog is set.
+type CompleteSprintRequest struct {
+	NextSprintID *string `json:"next_sprint_id"`
+	ToBacklog    bool    `json:"to_backlog"`
+}
+
+// SprintReport is the outcome of a completed sprint.
+type SprintReport struct {
+	SprintID         string        `json:"sprint_id"`
+	Completed        []SprintIssue `json:"completed"`
+	Incomplete       []SprintIssue `json:"incomplete"`
+	RolledOverTo     *string       `json:"rolled_over_to"` // nil = backlog
+	CompletedPoints  int           `json:"completed_points"`
+	IncompletePoints int           `json:"incomplete_points"`
+	CompletedAt      time.Time     `json:"completed_at"`
+}
+
+type SprintIssue struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Status      string `json:"status"`
+	IssueType   string `json:"issue_type"`
+	StoryPoints *int   `json:"story_points,omitempty"`
+}
+
+// SprintMove is one issue moved between sprints (nil = backlog).
+type SprintMove struct {
+	IssueID string  `json:"issue_id"`
+	From    *string `json:"from"`
+	To      *string `json:"to"`
+}
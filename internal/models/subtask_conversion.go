@@ -0,0 +1,13 @@
+package models
+
+// PromoteSubtaskRequest turns a subtask into a full issue of the parent's
+// project.
+type PromoteSubtaskRequest struct {
+	ColumnID *string `json:"column_id"` // defaults to the first board column
+	Priority string  `json:"priority"`  // defaults to the parent's priority
+}
+
+// DemoteIssueRequest turns an issue into a subtask of another issue.
+type DemoteIssueRequest struct {
+	ParentIssueID string `json:"parent_issue_id"`
+}
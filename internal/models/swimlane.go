@@ -0,0 +1,45 @@
+package models
+
+// Swimlane groupings for the kanban board.
+const (
+	SwimlaneAssignee = "assignee"
+	SwimlanePriority = "priority"
+	SwimlaneEpic     = "epic" // nearest epic ancestor
+	SwimlaneLabel    = "label" // first label by name
+)
+
+var SwimlaneGroups = map[string]bool{
+	SwimlaneAssignee: true,
+	SwimlanePriority: true,
+	SwimlaneEpic:     true,
+	SwimlaneLabel:    true,
+}
+
+// LaneKey places a card in a lane. An empty Key is the "none" lane
+// (unassigned, no epic, no label).
+type LaneKey struct {
+	Key   string
+	Title string
+}
+
+// Swimlane is one row of the board. Cells follow the board's columns and
+// keep the column order of their cards.
+type Swimlane struct {
+	Key       *string        `json:"key"` // nil = none
+	Title     string         `json:"title"`
+	CardCount int            `json:"card_count"`
+	Cells     []SwimlaneCell `json:"cells"`
+}
+
+type SwimlaneCell struct {
+	ColumnID string  `json:"column_id"`
+	Count    int     `json:"count"`
+	Cards    []Issue `json:"cards"`
+}
+
+// LaneMove drops a card into another lane, which rewrites the grouped
+// attribute (assignee, priority, epic or label). A nil Key clears it.
+type LaneMove struct {
+	Group string  `json:"group"`
+	Key   *string `json:"key"`
+}
@@ -0,0 +1,38 @@
+package models
+
+// MoveIssueRequest moves an issue (with its comments, checklists, subtasks
+// and attachments) into another project of the same customer.
+type MoveIssueRequest struct {
+	TargetProjectID string  `json:"target_project_id"`
+	// Target column; defaults to the column with the same name, else the
+	// first column of the target board.
+	ColumnID *string `json:"column_id"`
+	// Unassign instead of failing when the assignee is not a member of the
+	// target project.
+	UnassignNonMember bool `json:"unassign_non_member"`
+}
+
+// MoveIssueResult reports how the issue was mapped onto the target project.
+type MoveIssueResult struct {
+	Issue         *Issue            `json:"issue"`
+	FromProjectID string            `json:"from_project_id"`
+	FromColumnID  *string           `json:"from_column_id"`
+	ColumnID      *string           `json:"column_id"`
+	Unassigned    bool              `json:"unassigned"`
+	// old label id -> label id with the same name in the target project
+	LabelsMapped  map[string]string `json:"labels_mapped"`
+	LabelsDropped []string          `json:"labels_dropped"`
+	// set when the card went past a soft WIP limit of its new column
+	WIPWarning *WIPWarning `json:"wip_warning,omitempty"`
+}
+
+// CloneIssueRequest copies an issue. Labels are always copied (remapped by
+// name when cloning into another project); the rest is opt-in.
+type CloneIssueRequest struct {
+	TargetProjectID *string `json:"target_project_id"` // defaults to the source project
+	Title           *string `json:"title"`             // defaults to the source title
+	Subtasks        bool    `json:"subtasks"`
+	Checklists      bool    `json:"checklists"`
+	Attachments     bool    `json:"attachments"`
+	Relations       bool    `json:"relations"`
+}
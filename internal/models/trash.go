@@ -0,0 +1,26 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	TrashTypeIssue   = "issue"
+	TrashTypeComment = "comment"
+)
+
+var ErrNotInTrash = errors.New("item not found in trash")
+
+// TrashItem is one entry of a project's trash. Comments that were deleted
+// together with their issue are not listed separately; restoring the issue
+// brings them back.
+type TrashItem struct {
+	Type          string    `json:"type"`
+	ID            string    `json:"id"`
+	IssueID       *string   `json:"issue_id,omitempty"` // comments only
+	Title         string    `json:"title"`              // issue title, or comment excerpt
+	DeletedAt     time.Time `json:"deleted_at"`
+	DeletedBy     *string   `json:"deleted_by"`
+	DeletedByName *string   `json:"deleted_by_name"`
+}
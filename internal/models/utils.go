@@ -5,3 +5,11 @@ import "github.com/google/uuid"
 func NewUUID() string {
     return uuid.New().String()
 }
+
+// SameID reports whether two optional ids are both unset or equal.
+func SameID(a, b *string) bool {
+    if a == nil || b == nil {
+        return a == b
+    }
+    return *a == *b
+}
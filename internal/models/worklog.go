@@ -0,0 +1,44 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrWorklogNotFound = errors.New("worklog not found")
+
+// Worklog is time spent by a user on an issue. WorkDate is YYYY-MM-DD.
+type Worklog struct {
+	ID        string    `json:"id"`
+	IssueID   string    `json:"issue_id"`
+	UserID    string    `json:"user_id"`
+	Minutes   int       `json:"minutes"`
+	WorkDate  string    `json:"work_date"`
+	Comment   string    `json:"comment"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Timesheet is the time a user logged per day over a date range.
+type Timesheet struct {
+	UserID       string         `json:"user_id"`
+	From         string         `json:"from"`
+	To           string         `json:"to"`
+	TotalMinutes int            `json:"total_minutes"`
+	Days         []TimesheetDay `json:"days"`
+}
+
+type TimesheetDay struct {
+	Date         string           `json:"date"`
+	TotalMinutes int              `json:"total_minutes"`
+	Entries      []TimesheetEntry `json:"entries"`
+}
+
+// TimesheetEntry sums one user's worklogs on one issue for one day.
+type TimesheetEntry struct {
+	Date       string `json:"-"`
+	IssueID    string `json:"issue_id"`
+	IssueTitle string `json:"issue_title"`
+	ProjectID  string `json:"project_id"`
+	Minutes    int    `json:"minutes"`
+}
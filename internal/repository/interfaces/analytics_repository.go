@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type AnalyticsRepository interface {
+	// ListFlowIssues returns every issue of the project, trashed ones
+	// included.
+	ListFlowIssues(ctx context.Context, projectID string) ([]models.FlowIssue, error)
+	ListColumns(ctx context.Context, projectID string) ([]models.FlowColumn, error)
+
+	// ListStatusEvents returns status changes of the project's issues from
+	// single edits and bulk edits, oldest first.
+	ListStatusEvents(ctx context.Context, projectID string) ([]models.IssueEvent, error)
+	// ListSprintEvents returns the sprint changes into or out of sprintID,
+	// oldest first.
+	ListSprintEvents(ctx context.Context, sprintID string) ([]models.IssueEvent, error)
+	// ListColumnMoves returns the column-move history of the project's
+	// issues, oldest first.
+	ListColumnMoves(ctx context.Context, projectID string) ([]models.IssueEvent, error)
+}
@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type ChecklistTemplateRepository interface {
+	// CreateTemplate stores the template and its first version.
+	CreateTemplate(ctx context.Context, t *models.ChecklistTemplate) error
+	// UpdateTemplate renames the template and, when title or items changed,
+	// records them as a new version authored by editorID.
+	UpdateTemplate(ctx context.Context, t *models.ChecklistTemplate, editorID string) error
+	DeleteTemplate(ctx context.Context, templateID string) error
+	GetTemplateByID(ctx context.Context, templateID string) (*models.ChecklistTemplate, error)
+	ListTemplatesByProject(ctx context.Context, projectID string) ([]models.ChecklistTemplate, error)
+	ListVersions(ctx context.Context, templateID string) ([]models.ChecklistTemplateVersion, error)
+
+	// Apply copies one version of the template onto an issue as a new
+	// checklist with its items, atomically.
+	Apply(ctx context.Context, templateID string, version int, issueID string) (*models.Checklist, error)
+}
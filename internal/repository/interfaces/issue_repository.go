@@ -10,26 +10,85 @@ type IssueFilter struct {
     Status     *string
     Priority   *string
     AssignedTo *string
+    IssueType  *string
+    EpicID     *string // descendants of this issue, at any depth
+    SprintID   *string
+    Backlog    bool    // only issues without a sprint
+    FixVersionID *string
     Search     *string
-    SortBy     string
-    Direction  string
+    Sort       []IssueSort
     Limit      int
     Offset     int
 }
 
+// IssueSort is one key of a (possibly multi-key) issue ordering.
+// Field must be one of SortableIssueFields or CustomFieldSortPrefix+<key>.
+type IssueSort struct {
+    Field string
+    Desc  bool
+}
+
+const CustomFieldSortPrefix = "custom."
+
+// SortableIssueFields is the allow-list of built-in sort keys.
+var SortableIssueFields = map[string]bool{
+    "created_at": true,
+    "updated_at": true,
+    "priority":   true, // by rank: low < medium < high < critical
+    "due_date":   true, // issues without a due date always sort last
+    "title":      true,
+    "assignee":   true, // assignee display name
+}
+
 type IssueRepository interface {
     Create(ctx context.Context, issue *models.Issue) error
-	ListAll(ctx context.Context, customerID string) ([]models.IssueWithUser, error)
+	ListAll(ctx context.Context, customerID string, p models.PageRequest) ([]models.IssueWithUser, models.PageInfo, error)
+    // CreateFromTemplate inserts the issue with the template's labels,
+    // checklists and subtasks in one transaction. Placed on the board, the
+    // card may go past a soft WIP limit; the warning is returned.
+    CreateFromTemplate(ctx context.Context, issue *models.Issue, tpl *models.IssueTemplate) (*models.WIPWarning, error)
     GetByID(ctx context.Context, issueID string) (*models.Issue, error)
     ListByProject(ctx context.Context, projectID string, f IssueFilter) ([]models.IssueWithUser, error)
-    Update(ctx context.Context, issue *models.Issue) error
-    Delete(ctx context.Context, issueID string) error
+    // Update, UpdateComment and UpdateChecklistItem bump the stored
+    // version. With a non-nil expectedVersion they only apply when the
+    // stored version equals it; otherwise they return
+    // models.ErrVersionConflict.
+    Update(ctx context.Context, issue *models.Issue, expectedVersion *int) error
+    // Delete moves the issue (and its live comments) to the trash. It keeps
+    // its kanban column and rank.
+    Delete(ctx context.Context, issueID, deletedBy string) error
+    GetDeletedByID(ctx context.Context, issueID string) (*models.Issue, error)
+    // Restore brings the issue back together with the comments that were
+    // trashed with it, re-inserting it at its old kanban position.
+    Restore(ctx context.Context, issueID string) error
+    ListTrash(ctx context.Context, projectID string) ([]models.TrashItem, error)
+    // PurgeDeleted hard-deletes issues and comments trashed before the cutoff.
+    PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
+
+    // BulkApply applies ch to the given issues of a project in a single
+    // transaction and reports the outcome per issue.
+    BulkApply(ctx context.Context, projectID string, issueIDs []string, ch models.BulkIssueChange, actorID string) ([]models.BulkItemResult, error)
+
+    // MoveToProject re-keys an issue to another project, remapping its
+    // column and labels by name; clearAssignee drops the assignee.
+    MoveToProject(ctx context.Context, issueID, targetProjectID string, columnID *string, clearAssignee bool) (*models.MoveIssueResult, error)
+    // Clone inserts clone as a copy of sourceID (labels always, children as
+    // requested) and links it back with a cloned_from relation. Like
+    // MoveToProject it reports a soft WIP limit the card went past.
+    Clone(ctx context.Context, sourceID string, clone *models.Issue, req models.CloneIssueRequest) (*models.WIPWarning, error)
+
+    // ListAncestors returns the live parents of an issue, nearest first.
+    ListAncestors(ctx context.Context, issueID string) ([]models.Issue, error)
+    // ListDescendants returns every live issue below issueID, at any depth.
+    ListDescendants(ctx context.Context, issueID string) ([]models.Issue, error)
 
     CreateComment(ctx context.Context, c *models.IssueComment) error
     GetCommentByID(ctx context.Context, id string) (*models.IssueComment, error)
-    UpdateComment(ctx context.Context, c *models.IssueComment) error
-    DeleteComment(ctx context.Context, id string) error
-    ListCommentsByIssue(ctx context.Context, issueID string) ([]models.IssueComment, error)
+    UpdateComment(ctx context.Context, c *models.IssueComment, expectedVersion *int) error
+    DeleteComment(ctx context.Context, id, deletedBy string) error
+    GetDeletedCommentByID(ctx context.Context, id string) (*models.IssueComment, error)
+    RestoreComment(ctx context.Context, id string) error
+    ListCommentsByIssue(ctx context.Context, issueID string, p models.PageRequest) ([]models.IssueComment, models.PageInfo, error)
 
     CreateAttachment(ctx context.Context, a *models.IssueAttachment) error
     ListAttachmentsByIssue(ctx context.Context, issueID string) ([]models.IssueAttachment, error)
@@ -37,7 +96,9 @@ type IssueRepository interface {
 
     CreateChecklist(ctx context.Context, cl *models.Checklist) error
     CreateChecklistItem(ctx context.Context, it *models.ChecklistItem) error
-    UpdateChecklistItem(ctx context.Context, it *models.ChecklistItem) error
+    GetChecklistByID(ctx context.Context, id string) (*models.Checklist, error)
+    GetChecklistItemByID(ctx context.Context, id string) (*models.ChecklistItem, error)
+    UpdateChecklistItem(ctx context.Context, it *models.ChecklistItem, expectedVersion *int) error
     ListChecklistsByIssue(ctx context.Context, issueID string) ([]models.Checklist, error)
     DeleteChecklist(ctx context.Context, checklistID string) error
     DeleteChecklistItem(ctx context.Context, itemID string) error
@@ -48,10 +109,22 @@ type IssueRepository interface {
     UpdateSubtask(ctx context.Context, s *models.Subtask) error
     ListSubtasksByParent(ctx context.Context, parentIssueID string) ([]models.Subtask, error)
     DeleteSubtask(ctx context.Context, id string) error
+    // PromoteSubtask replaces a subtask with a full issue on the board and
+    // returns the soft WIP limit warning of its column, if any.
+    PromoteSubtask(ctx context.Context, subtaskID string, issue *models.Issue, columnID *string) (*models.WIPWarning, error)
+    // DemoteToSubtask trashes the issue and adds sub to its new parent.
+    DemoteToSubtask(ctx context.Context, issueID string, sub *models.Subtask, deletedBy string) error
 
     CreateRelation(ctx context.Context, r *models.IssueRelation) error
     ListRelations(ctx context.Context, issueID string) ([]models.IssueRelation, error)
     DeleteRelation(ctx context.Context, id string) error
 
     UpdateDueDate(ctx context.Context, issueID string, dueDate *time.Time) error
+
+    // GetProgress returns checklist/subtask rollups keyed by issue id;
+    // issues with neither are absent.
+    GetProgress(ctx context.Context, issueIDs []string) (map[string]*models.IssueProgress, error)
+    // GetTimeSpent sums worklog minutes keyed by issue id; issues without
+    // worklogs are absent.
+    GetTimeSpent(ctx context.Context, issueIDs []string) (map[string]int, error)
 }
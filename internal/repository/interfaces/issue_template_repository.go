@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type IssueTemplateRepository interface {
+	CreateTemplate(ctx context.Context, t *models.IssueTemplate) error
+	UpdateTemplate(ctx context.Context, t *models.IssueTemplate) error
+	DeleteTemplate(ctx context.Context, templateID string) error
+	GetTemplateByID(ctx context.Context, templateID string) (*models.IssueTemplate, error)
+	ListTemplatesByProject(ctx context.Context, projectID string) ([]models.IssueTemplate, error)
+}
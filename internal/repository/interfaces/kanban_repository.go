@@ -10,27 +10,80 @@ type KanbanRepository interface {
     GetCardByID(ctx context.Context, id string) (*models.Issue, error)
     CreateCard(ctx context.Context, card *models.Issue) error
     UpdateCardPosition(ctx context.Context, card *models.Issue) error
+    // RecordColumnMove appends to the column-move history used by analytics.
+    RecordColumnMove(ctx context.Context, issueID, fromColumnID, toColumnID string) error
 
     // COLUMN OPERATIONS
+    GetColumnByID(ctx context.Context, id string) (*models.KanbanColumn, error)
+    // LockColumn is GetColumnByID taking a row lock (inside Tx), for writes
+    // on the column that do not add cards to it.
+    LockColumn(ctx context.Context, id string) (*models.KanbanColumn, error)
+    // AdmitCards locks the column (inside Tx) and checks that n more cards
+    // fit under its WIP limit; see postgres.AdmitCards.
+    AdmitCards(ctx context.Context, columnID string, n int) (*models.WIPWarning, error)
     CreateColumn(ctx context.Context, col *models.KanbanColumn) error
-    GetNextColumnOrder(ctx context.Context, projectID string) (int, error)
 
-    // ORDERING HELPERS
-    GetNextOrder(ctx context.Context, columnID string) (int, error)
-    ShiftOrders(ctx context.Context, columnID string, fromOrder int) error
+    // RANKING HELPERS
+    // Cards and columns are ordered by fractional ranks (see models.RankBetween).
+    // The tails return the highest rank and the number of rows; neighbours
+    // are the ranks around a 1-based position, "" marking either end.
+    GetCardTail(ctx context.Context, columnID string) (string, int, error)
+    GetCardNeighbors(ctx context.Context, columnID, cardID string, pos int) (string, string, error)
+    GetColumnTail(ctx context.Context, boardID string) (string, int, error)
+    GetColumnNeighbors(ctx context.Context, boardID, columnID string, pos int) (string, string, error)
+    RebalanceCards(ctx context.Context, columnID string) error
+    RebalanceColumns(ctx context.Context, boardID string) error
+    // ListLongRanks returns the columns (card ranks) and boards (column
+    // ranks) holding ranks longer than maxLen.
+    ListLongRanks(ctx context.Context, maxLen int) ([]string, []string, error)
+    // ListCardLanes keys live cards by swimlane (all cards when cardID is nil).
+    ListCardLanes(ctx context.Context, projectID, group string, cardID *string) (map[string]models.LaneKey, error)
+    // ApplyLaneMove sets the attribute a swimlane groups by (nil clears it).
+    // For epic lanes cardID is the issue to re-parent, which may be an
+    // ancestor of the moved card.
+    ApplyLaneMove(ctx context.Context, projectID, cardID, group string, from, to *string) error
 
-    ShiftOrdersDown(ctx context.Context, columnID string, fromOrder int) error
-    ShiftRangeUp(ctx context.Context, columnID string, start, end int) error
-    ShiftRangeDown(ctx context.Context, columnID string, start, end int) error
-    GetColumnsWithCards(ctx context.Context, projectID string) ([]models.KanbanColumnWithCards, error)
+    // GetColumnsWithCards loads a board with the project's cards matching
+    // every filter, placed in the board's columns; sprintID limits them to
+    // one sprint (card counts are not filtered).
+    GetColumnsWithCards(ctx context.Context, boardID string, sprintID *string, filters []models.BoardFilter) ([]models.KanbanColumnWithCards, error)
 
-    ReorderColumnsShiftUp(ctx context.Context, projectID string, newOrder, oldOrder int, columnID string) error
-    ReorderColumnsShiftDown(ctx context.Context, projectID string, oldOrder, newOrder int, columnID string) error
-    UpdateColumnOrder(ctx context.Context, columnID string, newOrder int) error
+    // BOARDS
+    ListBoards(ctx context.Context, projectID string) ([]models.Board, error)
+    GetBoard(ctx context.Context, id string) (*models.Board, error)
+    // EnsureDefaultBoard returns the project's default board, creating it if needed.
+    EnsureDefaultBoard(ctx context.Context, projectID, createdBy string) (*models.Board, error)
+    CreateBoard(ctx context.Context, b *models.Board) error
+    UpdateBoard(ctx context.Context, b *models.Board) error
+    // DeleteBoard never deletes the default board.
+    DeleteBoard(ctx context.Context, id string) error
+    // ListBoardColumnIDs lists the board's columns, archived ones included.
+    ListBoardColumnIDs(ctx context.Context, boardID string) ([]string, error)
+
+    // QUICK FILTERS
+    ListQuickFilters(ctx context.Context, projectID string) ([]models.QuickFilter, error)
+    GetQuickFilter(ctx context.Context, id string) (*models.QuickFilter, error)
+    CreateQuickFilter(ctx context.Context, qf *models.QuickFilter) error
+    UpdateQuickFilter(ctx context.Context, qf *models.QuickFilter) error
+    DeleteQuickFilter(ctx context.Context, id string) error
+
+    UpdateColumnRank(ctx context.Context, columnID, rank string) error
     UpdateColumnName(ctx context.Context, columnID, name string) error
+    // UpdateColumnWIPLimit sets the limit (nil = none) and its mode.
+    UpdateColumnWIPLimit(ctx context.Context, columnID string, limit *int, mode string) error
+    // SetColumnArchived archives a column (nil archivedBy unarchives it).
+    SetColumnArchived(ctx context.Context, columnID string, archivedBy *string) error
+    ListArchivedColumns(ctx context.Context, boardID string) ([]models.KanbanColumn, error)
+    CountCards(ctx context.Context, columnID string) (int, error)
+    // RelocateCards appends every card of a column to another one, keeping
+    // their order, and returns the ids of the live cards moved.
+    RelocateCards(ctx context.Context, fromColumnID, toColumnID string) ([]string, error)
     DeleteColumn(ctx context.Context, columnID string) error
-    DeleteCardsByColumn(ctx context.Context, columnID string) error
-    DeleteCard(ctx context.Context, cardID string) error
+    // DetachTrashedCards unlinks the trashed cards of a column before it
+    // is deleted.
+    DetachTrashedCards(ctx context.Context, columnID string) error
+    // Card deletes are soft: the card goes to the project trash.
+    DeleteCard(ctx context.Context, cardID, deletedBy string) error
 
 
 
@@ -4,7 +4,7 @@ import "bugforge-backend/internal/models"
 
 type NotificationRepository interface {
     Create(notification *models.Notification) error
-    GetByUser(userID string) ([]models.Notification, error)
+    GetByUser(userID string, p models.PageRequest) ([]models.Notification, models.PageInfo, error)
     MarkRead(id string) error
     MarkAllRead(userID string) error
 }
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type PortfolioRepository interface {
+	// CreatePortfolio and UpdatePortfolio write the whole definition:
+	// projects, columns in their slice order and the column mappings.
+	CreatePortfolio(ctx context.Context, p *models.Portfolio) error
+	UpdatePortfolio(ctx context.Context, p *models.Portfolio) error
+	DeletePortfolio(ctx context.Context, portfolioID string) error
+	GetPortfolioByID(ctx context.Context, portfolioID string) (*models.Portfolio, error)
+	ListPortfolios(ctx context.Context, customerID string) ([]models.Portfolio, error)
+
+	// ListProjectColumns returns the kanban columns of the projects, on
+	// every board, archived ones included.
+	ListProjectColumns(ctx context.Context, projectIDs []string) ([]models.KanbanColumn, error)
+	// ListPortfolioCards returns the live cards of the given projects keyed
+	// by the portfolio column their project column maps into.
+	ListPortfolioCards(ctx context.Context, portfolioID string, projectIDs []string) (map[string][]models.PortfolioCard, error)
+}
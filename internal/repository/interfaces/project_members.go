@@ -8,7 +8,7 @@ import (
 type ProjectMemberRepository interface {
     AddMember(ctx context.Context, projectID, userID string) error
     RemoveMember(ctx context.Context, projectID, userID string) error
-    ListMembers(ctx context.Context, projectID, customerID string) ([]models.User, error)
+    ListMembers(ctx context.Context, projectID, customerID string, p models.PageRequest) ([]models.User, models.PageInfo, error)
     IsMember(ctx context.Context, projectID, userID string) (bool, error)
 
     GetAssignedProjectIDsForUser(ctx context.Context, userID string) ([]string, error)
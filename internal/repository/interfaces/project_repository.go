@@ -3,6 +3,7 @@ package interfaces
 import (
 	"bugforge-backend/internal/models"
 	"context"
+	"time"
 )
 
 type ProjectRepository interface {
@@ -11,5 +12,10 @@ type ProjectRepository interface {
     GetByID(ctx context.Context, id string, customerID string) (*models.Project, error)
     GetBySlug(ctx context.Context, slug string, customerID string) (*models.Project, error)
     Update(ctx context.Context, p *models.Project) error
-    Delete(ctx context.Context, id string, customerID string) error
+    // Delete moves the project and its live issues and comments to the trash.
+    Delete(ctx context.Context, id string, customerID string, deletedBy string) error
+    GetDeletedByID(ctx context.Context, id string, customerID string) (*models.Project, error)
+    ListDeleted(ctx context.Context, customerID string) ([]models.Project, error)
+    Restore(ctx context.Context, id string, customerID string) error
+    PurgeDeleted(ctx context.Context, before time.Time) (int64, error)
 }
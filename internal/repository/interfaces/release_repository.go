@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type ReleaseRepository interface {
+	CreateRelease(ctx context.Context, r *models.Release) error
+	// UpdateRelease writes every editable field; released_at is stamped
+	// when Released flips to true and cleared when it flips back.
+	UpdateRelease(ctx context.Context, r *models.Release) error
+	DeleteRelease(ctx context.Context, releaseID string) error
+	// GetReleaseByID and ListReleasesByProject include progress.
+	GetReleaseByID(ctx context.Context, releaseID string) (*models.Release, error)
+	ListReleasesByProject(ctx context.Context, projectID string) ([]models.Release, error)
+
+	// SetFixVersion points the issues of the project at releaseID (nil
+	// clears it) and returns the previous fix version of each changed issue.
+	SetFixVersion(ctx context.Context, projectID string, releaseID *string, issueIDs []string) (map[string]*string, error)
+	// ListChangelogEntries returns the finished issues of the release.
+	ListChangelogEntries(ctx context.Context, releaseID string) ([]models.ChangelogEntry, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type SearchRepository interface {
+	Search(ctx context.Context, customerID string, q models.SearchQuery) ([]models.SearchResult, error)
+}
@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+	"time"
+)
+
+type SprintRepository interface {
+	CreateSprint(ctx context.Context, s *models.Sprint) error
+	UpdateSprint(ctx context.Context, s *models.Sprint) error
+	DeleteSprint(ctx context.Context, sprintID string) error
+	GetSprintByID(ctx context.Context, sprintID string) (*models.Sprint, error)
+	ListSprintsByProject(ctx context.Context, projectID string) ([]models.Sprint, error)
+	GetActiveSprint(ctx context.Context, projectID string) (*models.Sprint, error)
+
+	// StartSprint activates a planned sprint; it fails when the project
+	// already has an active one.
+	StartSprint(ctx context.Context, sprintID string, start, end time.Time) error
+	// CompleteSprint closes an active sprint, moves its unfinished issues to
+	// nextSprintID (nil = backlog) and stores the report on the sprint.
+	CompleteSprint(ctx context.Context, sprintID string, nextSprintID *string) (*models.SprintReport, error)
+
+	// MoveIssues puts issues of the project into sprintID (nil = backlog),
+	// appended to the end of that list. Issues already there are skipped.
+	MoveIssues(ctx context.Context, projectID string, sprintID *string, issueIDs []string) ([]models.SprintMove, error)
+	// ListIssues returns the issues of a sprint (nil = backlog) in rank order.
+	ListIssues(ctx context.Context, projectID string, sprintID *string) ([]models.Issue, error)
+	// RankIssues sets backlog_order from the position of each id.
+	RankIssues(ctx context.Context, projectID string, issueIDs []string) error
+}
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type WorklogRepository interface {
+	CreateWorklog(ctx context.Context, w *models.Worklog) error
+	UpdateWorklog(ctx context.Context, w *models.Worklog) error
+	DeleteWorklog(ctx context.Context, worklogID string) error
+	GetWorklogByID(ctx context.Context, worklogID string) (*models.Worklog, error)
+	ListWorklogsByIssue(ctx context.Context, issueID string) ([]models.Worklog, error)
+
+	// ListTimesheet sums the user's worklogs per day and issue between from
+	// and to (inclusive, YYYY-MM-DD) within the customer's projects.
+	// projectID optionally narrows it to one project.
+	ListTimesheet(ctx context.Context, customerID, userID, from, to string, projectID *string) ([]models.TimesheetEntry, error)
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+
+	"bugforge-backend/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const boardColumns = `id, project_id, name, filter, is_default, created_by, created_at, updated_at`
+
+func scanBoard(row pgx.Row) (*models.Board, error) {
+    var b models.Board
+    err := row.Scan(&b.ID, &b.ProjectID, &b.Name, &b.Filter, &b.IsDefault, &b.CreatedBy, &b.CreatedAt, &b.UpdatedAt)
+    if err == pgx.ErrNoRows {
+        return nil, models.ErrBoardNotFound
+    }
+    if err != nil {
+        return nil, err
+    }
+    return &b, nil
+}
+
+// ListBoards returns the boards of a project, the default one first.
+func (r *KanbanRepo) ListBoards(ctx context.Context, projectID string) ([]models.Board, error) {
+    rows, err := r.exec.Query(ctx, `
+        SELECT `+boardColumns+`
+        FROM boards
+        WHERE project_id = $1
+        ORDER BY is_default DESC, lower(name) ASC
+    `, projectID)
+    if err != nil {
+        return nil, err
+    }
+    defer rows.Close()
+
+    out := []models.Board{}
+    for rows.Next() {
+        b, err := scanBoard(rows)
+        if err != nil {
+            return nil, err
+        }
+        out = append(out, *b)
+    }
+    return out, rows.Err()
+}
+
+func (r *KanbanRepo) GetBoard(ctx context.Context, id string) (*models.Board, error) {
+    return scanBoard(r.exec.QueryRow(ctx, `
+        SELECT `+boardColumns+` FROM boards WHERE id = $1
+    `, id))
+}
+
+// EnsureDefaultBoard returns the default board of the project, creating it
+// on first use.
+func (r *KanbanRepo) EnsureDefaultBoard(ctx context.Context, projectID, createdBy string) (*models.Board, error) {
+    _, err := r.exec.Exec(ctx, `
+        INSERT INTO boards (id, project_id, name, is_default, created_by)
+        VALUES ($1, $2, 'Board', TRUE, $3)
+        ON CONFLICT DO NOTHING
+    `, uuid.NewString(), projectID, createdBy)
+    if err != nil {
+        return nil, err
+    }
+    return scanBoard(r.exec.QueryRow(ctx, `
+        SELECT `+boardColumns+` FROM boards WHERE project_id = $1 AND is_default
+    `, projectID))
+}
+
+func (r *KanbanRepo) CreateBoard(ctx context.Context, b *models.Board) error {
+    return r.exec.QueryRow(ctx, `
+        INSERT INTO boards (id, project_id, name, filter, is_default, created_by)
+        VALUES ($1, $2, $3, $4, FALSE, $5)
+        RETURNING created_at, updated_at
+    `, b.ID, b.ProjectID, b.Name, b.Filter, b.CreatedBy).Scan(&b.CreatedAt, &b.UpdatedAt)
+}
+
+func (r *KanbanRepo) UpdateBoard(ctx context.Context, b *models.Board) error {
+    err := r.exec.QueryRow(ctx, `
+        UPDATE boards
+        SET name = $2, filter = $3, updated_at = NOW()
+        WHERE id = $1
+        RETURNING updated_at
+    `, b.ID, b.Name, b.Filter).Scan(&b.UpdatedAt)
+    if err == pgx.ErrNoRows {
+        return models.ErrBoardNotFound
+    }
+    return err
+}
+
+// DeleteBoard removes the board; its columns go with it. Callers empty the
+// columns first (DetachTrashedCards).
+func (r *KanbanRepo) DeleteBoard(ctx context.Context, id string) error {
+    tag, err := r.exec.Exec(ctx, `DELETE FROM boards WHERE id = $1 AND NOT is_default`, id)
+    if err != nil {
+        return err
+    }
+    if tag.RowsAffected() == 0 {
+        return models.ErrBoardNotFound
+    }
+    return nil
+}
+
+// ListBoardColumnIDs returns the ids of the board's columns, archived ones
+// included, in id order so that callers lock them in a stable order.
+func (r *KanbanRepo) ListBoardColumnIDs(ctx context.Context, boardID string) ([]string, error) {
+    return r.collectIDs(ctx, `
+        SELECT id FROM kanban_columns WHERE board_id = $1 ORDER BY id
+    `, boardID)
+}
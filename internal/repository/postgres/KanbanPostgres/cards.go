@@ -4,16 +4,23 @@ import (
 	"context"
 
 	"bugforge-backend/internal/models"
+	pg "bugforge-backend/internal/repository/postgres"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 func (r *KanbanRepo) GetCardByID(ctx context.Context, id string) (*models.Issue, error) {
     var card models.Issue
 
     query := `
-        SELECT id, project_id, column_id, title, description, "order",
-               created_by, created_at, updated_at
-        FROM issues
-        WHERE id = $1
+        SELECT i.id, i.project_id, i.column_id, i.title, i.description,
+               (SELECT COUNT(*) FROM issues o
+                WHERE o.column_id = i.column_id AND o.deleted_at IS NULL
+                  AND (o.rank, o.id) <= (i.rank, i.id)),
+               COALESCE(i.rank, ''), i.created_by, i.created_at, i.updated_at
+        FROM issues i
+        WHERE i.id = $1 AND i.deleted_at IS NULL
     `
     err := r.exec.QueryRow(ctx, query, id).Scan(
         &card.ID,
@@ -22,6 +29,7 @@ func (r *KanbanRepo) GetCardByID(ctx context.Context, id string) (*models.Issue,
         &card.Title,
         &card.Description,
         &card.Order,
+        &card.Rank,
         &card.CreatedBy,
         &card.CreatedAt,
         &card.UpdatedAt,
@@ -35,29 +43,45 @@ func (r *KanbanRepo) GetCardByID(ctx context.Context, id string) (*models.Issue,
 
 func (r *KanbanRepo) CreateCard(ctx context.Context, card *models.Issue) error {
     _, err := r.exec.Exec(ctx, `
-        INSERT INTO issues (id, project_id, column_id, title, description, "order", created_by)
-        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        INSERT INTO issues (id, project_id, column_id, title, description, rank, priority, assigned_to, created_by)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
     `,
         card.ID, card.ProjectID, card.ColumnID,
-        card.Title, card.Description, card.Order, card.CreatedBy,
+        card.Title, card.Description, card.Rank, card.Priority, card.AssignedTo, card.CreatedBy,
     )
     return err
 }
 
+// UpdateCardPosition writes the card's column and rank; no other row is
+// touched.
 func (r *KanbanRepo) UpdateCardPosition(ctx context.Context, card *models.Issue) error {
     _, err := r.exec.Exec(ctx, `
         UPDATE issues
         SET column_id = $2,
-            "order" = $3,
+            rank = $3,
+            version = version + 1,
             updated_at = NOW()
         WHERE id = $1
     `,
-        card.ID, card.ColumnID, card.Order,
+        card.ID, card.ColumnID, card.Rank,
     )
     return err
 }
 
-func (r *KanbanRepo) DeleteCard(ctx context.Context, cardID string) error {
-    _, err := r.exec.Exec(ctx, `DELETE FROM issues WHERE id = $1`, cardID)
+func (r *KanbanRepo) RecordColumnMove(ctx context.Context, issueID, fromColumnID, toColumnID string) error {
+    _, err := r.exec.Exec(ctx, `
+        INSERT INTO issue_column_moves (id, issue_id, from_column_id, to_column_id, moved_at)
+        VALUES ($1, $2, NULLIF($3, '')::uuid, $4, NOW())
+    `, uuid.NewString(), issueID, fromColumnID, toColumnID)
+    return err
+}
+
+// DeleteCard moves the card and its comments to the trash. The card keeps
+// its column and rank so Restore can put it back in place.
+func (r *KanbanRepo) DeleteCard(ctx context.Context, cardID, deletedBy string) error {
+    err := pg.SoftDeleteIssue(ctx, r.exec, cardID, deletedBy)
+    if err == pgx.ErrNoRows {
+        return nil
+    }
     return err
 }
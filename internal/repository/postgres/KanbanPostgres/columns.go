@@ -4,32 +4,111 @@ import (
 	"context"
 
 	"bugforge-backend/internal/models"
+	pg "bugforge-backend/internal/repository/postgres"
 )
 
 func (r *KanbanRepo) CreateColumn(ctx context.Context, col *models.KanbanColumn) error {
     _, err := r.exec.Exec(ctx, `
-        INSERT INTO kanban_columns (id, project_id, name, "order")
-        VALUES ($1, $2, $3, $4)
-    `, col.ID, col.ProjectID, col.Name, col.Order)
+        INSERT INTO kanban_columns (id, project_id, board_id, name, rank)
+        VALUES ($1, $2, $3, $4, $5)
+    `, col.ID, col.ProjectID, col.BoardID, col.Name, col.Rank)
     return err
 }
 
-func (r *KanbanRepo) GetNextColumnOrder(ctx context.Context, projectID string) (int, error) {
-    var next int
-    err := r.exec.QueryRow(ctx,
-        `SELECT COALESCE(MAX("order") + 1, 1)
-         FROM kanban_columns WHERE project_id = $1`,
-        projectID,
-    ).Scan(&next)
-    return next, err
+func (r *KanbanRepo) GetColumnByID(ctx context.Context, id string) (*models.KanbanColumn, error) {
+    var col models.KanbanColumn
+    err := r.exec.QueryRow(ctx, `
+        SELECT c.id, c.project_id, c.board_id, c.name,
+               (SELECT COUNT(*) FROM kanban_columns o
+                WHERE o.board_id = c.board_id AND o.archived_at IS NULL
+                  AND (o.rank, o.id) <= (c.rank, c.id)),
+               c.rank, c.wip_limit, c.wip_mode, c.archived_at
+        FROM kanban_columns c
+        WHERE c.id = $1
+    `, id).Scan(&col.ID, &col.ProjectID, &col.BoardID, &col.Name, &col.Order, &col.Rank, &col.WIPLimit, &col.WIPMode, &col.ArchivedAt)
+    if err != nil {
+        return nil, err
+    }
+    return &col, nil
+}
+
+// LockColumn loads the column and locks its row until the end of the
+// transaction, for writes on the column that do not add cards to it;
+// those go through AdmitCards, which takes the same lock.
+func (r *KanbanRepo) LockColumn(ctx context.Context, id string) (*models.KanbanColumn, error) {
+    var col models.KanbanColumn
+    err := r.exec.QueryRow(ctx, `
+        SELECT id, project_id, board_id, name, rank, wip_limit, wip_mode, archived_at
+        FROM kanban_columns
+        WHERE id = $1
+        FOR UPDATE
+    `, id).Scan(&col.ID, &col.ProjectID, &col.BoardID, &col.Name, &col.Rank, &col.WIPLimit, &col.WIPMode, &col.ArchivedAt)
+    if err != nil {
+        return nil, err
+    }
+    return &col, nil
+}
+
+// AdmitCards takes the column lock and applies the WIP limit shared with
+// the issue repository.
+func (r *KanbanRepo) AdmitCards(ctx context.Context, columnID string, n int) (*models.WIPWarning, error) {
+    return pg.AdmitCards(ctx, r.exec, columnID, n)
+}
+
+// GetColumnTail returns the highest column rank of the board, archived
+// columns included so that they can be unarchived in place, and the number
+// of visible columns.
+func (r *KanbanRepo) GetColumnTail(ctx context.Context, boardID string) (string, int, error) {
+    var last string
+    var count int
+    err := r.exec.QueryRow(ctx, `
+        SELECT COALESCE(MAX(rank), ''), COUNT(*) FILTER (WHERE archived_at IS NULL)
+        FROM kanban_columns WHERE board_id = $1
+    `, boardID).Scan(&last, &count)
+    return last, count, err
+}
+
+// GetColumnNeighbors returns the ranks around the 1-based position pos
+// among the visible columns, leaving out columnID. Empty strings mark the
+// ends.
+func (r *KanbanRepo) GetColumnNeighbors(ctx context.Context, boardID, columnID string, pos int) (string, string, error) {
+    var prev, next string
+    err := r.exec.QueryRow(ctx, `
+        SELECT COALESCE(MAX(rank) FILTER (WHERE n < $3), ''),
+               COALESCE(MIN(rank) FILTER (WHERE n >= $3), '')
+        FROM (
+            SELECT rank, ROW_NUMBER() OVER (ORDER BY rank, id) AS n
+            FROM kanban_columns
+            WHERE board_id = $1 AND id <> $2 AND archived_at IS NULL
+        ) s
+    `, boardID, columnID, pos).Scan(&prev, &next)
+    return prev, next, err
 }
 
-func (r *KanbanRepo) UpdateColumnOrder(ctx context.Context, columnID string, newOrder int) error {
+func (r *KanbanRepo) UpdateColumnRank(ctx context.Context, columnID, rank string) error {
     _, err := r.exec.Exec(ctx, `
         UPDATE kanban_columns
-        SET "order" = $2
+        SET rank = $2
         WHERE id = $1
-    `, columnID, newOrder)
+    `, columnID, rank)
+    return err
+}
+
+// RebalanceColumns respaces the column ranks of the board.
+func (r *KanbanRepo) RebalanceColumns(ctx context.Context, boardID string) error {
+    ids, err := r.collectIDs(ctx, `
+        SELECT id FROM kanban_columns WHERE board_id = $1
+        ORDER BY rank, id
+        FOR UPDATE
+    `, boardID)
+    if err != nil {
+        return err
+    }
+    _, err = r.exec.Exec(ctx, `
+        UPDATE kanban_columns c SET rank = v.rank
+        FROM unnest($1::uuid[], $2::text[]) AS v(id, rank)
+        WHERE c.id = v.id
+    `, ids, models.RankSequence(len(ids)))
     return err
 }
 
@@ -42,12 +121,129 @@ func (r *KanbanRepo) UpdateColumnName(ctx context.Context, columnID, name string
     return err
 }
 
+func (r *KanbanRepo) UpdateColumnWIPLimit(ctx context.Context, columnID string, limit *int, mode string) error {
+    _, err := r.exec.Exec(ctx, `
+        UPDATE kanban_columns
+        SET wip_limit = $2, wip_mode = $3
+        WHERE id = $1
+    `, columnID, limit, mode)
+    return err
+}
+
+// SetColumnArchived archives the column on behalf of archivedBy, or
+// unarchives it when archivedBy is nil. Rank and cards are left alone.
+func (r *KanbanRepo) SetColumnArchived(ctx context.Context, columnID string, archivedBy *string) error {
+    _, err := r.exec.Exec(ctx, `
+        UPDATE kanban_columns
+        SET archived_at = CASE WHEN $2::uuid IS NULL THEN NULL ELSE NOW() END,
+            archived_by = $2
+        WHERE id = $1
+    `, columnID, archivedBy)
+    return err
+}
+
+// ListArchivedColumns returns the archived columns of a board in rank order.
+func (r *KanbanRepo) ListArchivedColumns(ctx context.Context, boardID string) ([]models.KanbanColumn, error) {
+    rows, err := r.exec.Query(ctx, `
+        SELECT id, project_id, board_id, name, rank, wip_limit, wip_mode, archived_at
+        FROM kanban_columns
+        WHERE board_id = $1 AND archived_at IS NOT NULL
+        ORDER BY rank ASC, id
+    `, boardID)
+    if err != nil {
+        return nil, err
+    }
+    defer rows.Close()
+
+    out := []models.KanbanColumn{}
+    for rows.Next() {
+        var col models.KanbanColumn
+        if err := rows.Scan(&col.ID, &col.ProjectID, &col.BoardID, &col.Name, &col.Rank, &col.WIPLimit, &col.WIPMode, &col.ArchivedAt); err != nil {
+            return nil, err
+        }
+        out = append(out, col)
+    }
+    return out, rows.Err()
+}
+
+// RelocateCards moves every card of fromColumnID, trashed ones included,
+// to the end of toColumnID, keeping their relative order. It returns the
+// ids of the live cards moved, in order.
+func (r *KanbanRepo) RelocateCards(ctx context.Context, fromColumnID, toColumnID string) ([]string, error) {
+    rows, err := r.exec.Query(ctx, `
+        SELECT id, deleted_at IS NULL
+        FROM issues WHERE column_id = $1
+        ORDER BY rank ASC NULLS LAST, id
+        FOR UPDATE
+    `, fromColumnID)
+    if err != nil {
+        return nil, err
+    }
+    var ids, live []string
+    for rows.Next() {
+        var id string
+        var alive bool
+        if err := rows.Scan(&id, &alive); err != nil {
+            rows.Close()
+            return nil, err
+        }
+        ids = append(ids, id)
+        if alive {
+            live = append(live, id)
+        }
+    }
+    rows.Close()
+    if err := rows.Err(); err != nil {
+        return nil, err
+    }
+    if len(ids) == 0 {
+        return live, nil
+    }
+
+    last, _, err := r.GetCardTail(ctx, toColumnID)
+    if err != nil {
+        return nil, err
+    }
+    ranks := make([]string, len(ids))
+    for n := range ids {
+        last = models.RankAfter(last)
+        ranks[n] = last
+    }
+
+    _, err = r.exec.Exec(ctx, `
+        UPDATE issues i
+        SET column_id = $3, rank = v.rank, version = i.version + 1, updated_at = NOW()
+        FROM unnest($1::uuid[], $2::text[]) AS v(id, rank)
+        WHERE i.id = v.id
+    `, ids, ranks, toColumnID)
+    if err != nil {
+        return nil, err
+    }
+    return live, nil
+}
+
+// CountCards counts the live cards of a column.
+func (r *KanbanRepo) CountCards(ctx context.Context, columnID string) (int, error) {
+    var n int
+    err := r.exec.QueryRow(ctx,
+        `SELECT COUNT(*) FROM issues WHERE column_id = $1 AND deleted_at IS NULL`,
+        columnID,
+    ).Scan(&n)
+    return n, err
+}
+
 func (r *KanbanRepo) DeleteColumn(ctx context.Context, columnID string) error {
     _, err := r.exec.Exec(ctx, `DELETE FROM kanban_columns WHERE id = $1`, columnID)
     return err
 }
 
-func (r *KanbanRepo) DeleteCardsByColumn(ctx context.Context, columnID string) error {
-    _, err := r.exec.Exec(ctx, `DELETE FROM issues WHERE column_id = $1`, columnID)
+// DetachTrashedCards takes the trashed cards off a column that is about to
+// be removed. Live cards are left alone; callers move them out first.
+func (r *KanbanRepo) DetachTrashedCards(ctx context.Context, columnID string) error {
+    _, err := r.exec.Exec(ctx, `
+        UPDATE issues
+        SET column_id = NULL, version = version + 1
+        WHERE column_id = $1 AND deleted_at IS NOT NULL
+    `, columnID)
     return err
 }
@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"bugforge-backend/internal/models"
+	"bugforge-backend/internal/repository/interfaces"
+	"bugforge-backend/internal/repository/postgres/pgtest"
+)
+
+func TestRelocateCards(t *testing.T) {
+	p := pgtest.NewProject(t)
+	repo := NewKanbanRepo(p.DB)
+	ctx := context.Background()
+
+	from, to := p.Column("From"), p.Column("To")
+	kept := p.Issue(models.IssueTypeTask, to, "i", nil)
+	a := p.Issue(models.IssueTypeTask, from, "1", nil)
+	trashed := p.Issue(models.IssueTypeTask, from, "2", nil)
+	b := p.Issue(models.IssueTypeTask, from, "3", nil)
+	p.Exec(`UPDATE issues SET deleted_at = NOW() WHERE id = $1`, trashed)
+
+	var live []string
+	err := repo.Tx(ctx, func(tx interfaces.KanbanRepository) error {
+		var err error
+		live, err = tx.RelocateCards(ctx, from, to)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{a, b}; !reflect.DeepEqual(live, want) {
+		t.Errorf("live cards moved = %v, want %v", live, want)
+	}
+	if got := p.ColumnCards(from); len(got) != 0 {
+		t.Errorf("source column still holds %v", got)
+	}
+	// The moved cards, the trashed one too, go after the target's own
+	// cards in their old order.
+	if got, want := p.ColumnCards(to), []string{kept, a, trashed, b}; !reflect.DeepEqual(got, want) {
+		t.Errorf("target column = %v, want %v", got, want)
+	}
+}
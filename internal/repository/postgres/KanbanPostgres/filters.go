@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"bugforge-backend/internal/models"
+	pg "bugforge-backend/internal/repository/postgres"
+)
+
+// boardFilterSQL compiles board filters into conditions on the card alias
+// i, numbering placeholders from next. Every filter narrows the result, so
+// several quick filters applied together are combined with AND. OnlyMine
+// is resolved into AssigneeIDs by the service.
+func boardFilterSQL(filters []models.BoardFilter, next int) (string, []any) {
+    var sb strings.Builder
+    args := []any{}
+    bind := func(v any) string {
+        args = append(args, v)
+        return fmt.Sprintf("$%d", next+len(args)-1)
+    }
+
+    for _, f := range filters {
+        if len(f.AssigneeIDs) > 0 {
+            sb.WriteString(" AND i.assigned_to = ANY(" + bind(f.AssigneeIDs) + "::uuid[])")
+        }
+        if len(f.Priorities) > 0 {
+            sb.WriteString(" AND i.priority = ANY(" + bind(f.Priorities) + "::text[])")
+        }
+        if len(f.LabelIDs) > 0 {
+            sb.WriteString(` AND EXISTS (
+                SELECT 1 FROM issue_labels il
+                WHERE il.issue_id = i.id AND il.label_id = ANY(` + bind(f.LabelIDs) + `::uuid[]))`)
+        }
+        if f.Text != "" {
+            // Same matching as the issue list search
+            tsq, raw := bind(pg.ToPrefixTSQuery(f.Text)), bind(f.Text)
+            sb.WriteString(` AND (
+                i.search_vector @@ to_tsquery('english', ` + tsq + `)
+                OR i.title % ` + raw + `
+                OR EXISTS (
+                    SELECT 1 FROM issue_comments c
+                    WHERE c.issue_id = i.id AND c.deleted_at IS NULL AND c.search_vector @@ to_tsquery('english', ` + tsq + `)
+                )
+                OR EXISTS (
+                    SELECT 1 FROM issue_attachments a
+                    WHERE a.issue_id = i.id AND a.search_vector @@ to_tsquery('simple', ` + tsq + `)
+                ))`)
+        }
+        if f.DueFrom != "" {
+            sb.WriteString(" AND i.due_date >= " + bind(f.DueFrom) + "::date")
+        }
+        if f.DueTo != "" {
+            sb.WriteString(" AND i.due_date < " + bind(f.DueTo) + "::date + 1")
+        }
+        if f.UpdatedWithinDays > 0 {
+            sb.WriteString(" AND i.updated_at >= NOW() - make_interval(days => " + bind(f.UpdatedWithinDays) + ")")
+        }
+    }
+
+    return sb.String(), args
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"reflect"
+	"testing"
+
+	"bugforge-backend/internal/models"
+)
+
+func TestBoardFilterSQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		filters  []models.BoardFilter
+		wantSQL  string
+		wantArgs []any
+	}{
+		{name: "none", filters: nil, wantSQL: "", wantArgs: []any{}},
+		{name: "empty filter", filters: []models.BoardFilter{{}}, wantSQL: "", wantArgs: []any{}},
+		{
+			name:     "placeholders continue from next",
+			filters:  []models.BoardFilter{{Priorities: []string{"high"}, DueFrom: "2024-03-01"}},
+			wantSQL:  " AND i.priority = ANY($3::text[]) AND i.due_date >= $4::date",
+			wantArgs: []any{[]string{"high"}, "2024-03-01"},
+		},
+		{
+			name: "filters are combined with AND",
+			filters: []models.BoardFilter{
+				{AssigneeIDs: []string{"a"}},
+				{AssigneeIDs: []string{"b"}, UpdatedWithinDays: 3},
+			},
+			wantSQL: " AND i.assigned_to = ANY($3::uuid[])" +
+				" AND i.assigned_to = ANY($4::uuid[])" +
+				" AND i.updated_at >= NOW() - make_interval(days => $5)",
+			wantArgs: []any{[]string{"a"}, []string{"b"}, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args := boardFilterSQL(tt.filters, 3)
+			if sql != tt.wantSQL {
+				t.Errorf("sql = %q, want %q", sql, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"bugforge-backend/internal/models"
+)
+
+// ListCardLanes keys the project's live cards (or just cardID) by lane.
+func (r *KanbanRepo) ListCardLanes(ctx context.Context, projectID, group string, cardID *string) (map[string]models.LaneKey, error) {
+    if group == models.SwimlaneEpic {
+        return r.listEpicLanes(ctx, projectID, cardID)
+    }
+
+    var query string
+    switch group {
+    case models.SwimlaneAssignee:
+        query = `
+            SELECT i.id, COALESCE(i.assigned_to::text, ''), COALESCE(u.name, u.username, '')
+            FROM issues i
+            LEFT JOIN users u ON u.id = i.assigned_to`
+    case models.SwimlanePriority:
+        query = `
+            SELECT i.id, i.priority, i.priority
+            FROM issues i`
+    case models.SwimlaneLabel:
+        query = `
+            SELECT i.id, COALESCE(fl.id::text, ''), COALESCE(fl.name, '')
+            FROM issues i
+            LEFT JOIN LATERAL (
+                SELECT l.id, l.name
+                FROM issue_labels il
+                JOIN labels l ON l.id = il.label_id
+                WHERE il.issue_id = i.id
+                ORDER BY lower(l.name) ASC, l.id ASC
+                LIMIT 1
+            ) fl ON true`
+    default:
+        return nil, errors.New("invalid swimlane group")
+    }
+
+    rows, err := r.exec.Query(ctx, query+`
+        WHERE i.project_id = $1 AND i.deleted_at IS NULL
+          AND ($2::uuid IS NULL OR i.id = $2)
+    `, projectID, cardID)
+    if err != nil {
+        return nil, err
+    }
+    defer rows.Close()
+
+    out := map[string]models.LaneKey{}
+    for rows.Next() {
+        var id string
+        var k models.LaneKey
+        if err := rows.Scan(&id, &k.Key, &k.Title); err != nil {
+            return nil, err
+        }
+        out[id] = k
+    }
+    return out, rows.Err()
+}
+
+// listEpicLanes walks parent links in memory; a project's hierarchy is
+// small next to the board itself.
+func (r *KanbanRepo) listEpicLanes(ctx context.Context, projectID string, cardID *string) (map[string]models.LaneKey, error) {
+    rows, err := r.exec.Query(ctx, `
+        SELECT id, parent_issue_id::text, issue_type, title
+        FROM issues
+        WHERE project_id = $1 AND deleted_at IS NULL
+    `, projectID)
+    if err != nil {
+        return nil, err
+    }
+    defer rows.Close()
+
+    type node struct {
+        parent    *string
+        issueType string
+        title     string
+    }
+    nodes := map[string]node{}
+    for rows.Next() {
+        var id string
+        var n node
+        if err := rows.Scan(&id, &n.parent, &n.issueType, &n.title); err != nil {
+            return nil, err
+        }
+        nodes[id] = n
+    }
+    if err := rows.Err(); err != nil {
+        return nil, err
+    }
+
+    out := map[string]models.LaneKey{}
+    for id, n := range nodes {
+        if cardID != nil && id != *cardID {
+            continue
+        }
+        k := models.LaneKey{}
+        // depth cap guards against cycles in bad data
+        for p, depth := n.parent, 0; p != nil && depth < 100; depth++ {
+            parent, ok := nodes[*p]
+            if !ok {
+                break
+            }
+            if parent.issueType == models.IssueTypeEpic {
+                k = models.LaneKey{Key: *p, Title: parent.title}
+                break
+            }
+            p = parent.parent
+        }
+        out[id] = k
+    }
+    return out, nil
+}
+
+// ApplyLaneMove rewrites the card attribute behind the lane. For labels
+// the card's lane label (from) is swapped for the new one.
+func (r *KanbanRepo) ApplyLaneMove(ctx context.Context, projectID, cardID, group string, from, to *string) error {
+    switch group {
+    case models.SwimlaneAssignee:
+        _, err := r.exec.Exec(ctx, `
+            UPDATE issues SET assigned_to = $2, version = version + 1, updated_at = NOW()
+            WHERE id = $1
+        `, cardID, to)
+        return err
+
+    case models.SwimlanePriority:
+        if to == nil {
+            return errors.New("priority cannot be cleared")
+        }
+        _, err := r.exec.Exec(ctx, `
+            UPDATE issues SET priority = $2, version = version + 1, updated_at = NOW()
+            WHERE id = $1
+        `, cardID, *to)
+        return err
+
+    case models.SwimlaneEpic:
+        _, err := r.exec.Exec(ctx, `
+            UPDATE issues SET parent_issue_id = $2, version = version + 1, updated_at = NOW()
+            WHERE id = $1
+        `, cardID, to)
+        return err
+
+    case models.SwimlaneLabel:
+        if from != nil {
+            _, err := r.exec.Exec(ctx,
+                `DELETE FROM issue_labels WHERE issue_id = $1 AND label_id = $2`, cardID, *from)
+            if err != nil {
+                return err
+            }
+        }
+        if to == nil {
+            return nil
+        }
+        tag, err := r.exec.Exec(ctx, `
+            INSERT INTO issue_labels (issue_id, label_id)
+            SELECT $1, l.id FROM labels l
+            WHERE l.id = $2 AND l.project_id = $3
+            ON CONFLICT DO NOTHING
+        `, cardID, *to, projectID)
+        if err != nil {
+            return err
+        }
+        if tag.RowsAffected() == 0 {
+            var exists bool
+            err := r.exec.QueryRow(ctx,
+                `SELECT EXISTS (SELECT 1 FROM labels WHERE id = $1 AND project_id = $2)`, *to, projectID,
+            ).Scan(&exists)
+            if err != nil {
+                return err
+            }
+            if !exists {
+                return errors.New("label not found")
+            }
+        }
+        return nil
+    }
+    return errors.New("invalid swimlane group")
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"bugforge-backend/internal/models"
+	"bugforge-backend/internal/repository/interfaces"
+	"bugforge-backend/internal/repository/postgres/pgtest"
+)
+
+// laneKey returns the lane the card shows in under group.
+func laneKey(t *testing.T, repo interfaces.KanbanRepository, p *pgtest.Project, group, cardID string) string {
+	t.Helper()
+	keys, err := repo.ListCardLanes(context.Background(), p.ID, group, &cardID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keys[cardID].Key
+}
+
+func TestApplyLaneMove(t *testing.T) {
+	p := pgtest.NewProject(t)
+	repo := NewKanbanRepo(p.DB)
+	ctx := context.Background()
+	col := p.Column("Todo")
+
+	epicA := p.Issue(models.IssueTypeEpic, "", "", nil)
+	epicB := p.Issue(models.IssueTypeEpic, "", "", nil)
+	card := p.Issue(models.IssueTypeTask, col, "i", &epicA)
+	high := "high"
+
+	tests := []struct {
+		name  string
+		group string
+		from  *string
+		to    *string
+		want  string
+	}{
+		{name: "priority", group: models.SwimlanePriority, to: &high, want: "high"},
+		{name: "assignee", group: models.SwimlaneAssignee, to: &p.UserID, want: p.UserID},
+		{name: "unassign", group: models.SwimlaneAssignee, from: &p.UserID, want: ""},
+		{name: "epic", group: models.SwimlaneEpic, from: &epicA, to: &epicB, want: epicB},
+		{name: "no epic", group: models.SwimlaneEpic, from: &epicB, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := repo.Tx(ctx, func(tx interfaces.KanbanRepository) error {
+				return tx.ApplyLaneMove(ctx, p.ID, card, tt.group, tt.from, tt.to)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := laneKey(t, repo, p, tt.group, card); got != tt.want {
+				t.Errorf("lane after move = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyLaneMoveEpicThroughStory(t *testing.T) {
+	p := pgtest.NewProject(t)
+	repo := NewKanbanRepo(p.DB)
+	ctx := context.Background()
+	col := p.Column("Todo")
+
+	epicA := p.Issue(models.IssueTypeEpic, "", "", nil)
+	epicB := p.Issue(models.IssueTypeEpic, "", "", nil)
+	story := p.Issue(models.IssueTypeStory, "", "", &epicA)
+	card := p.Issue(models.IssueTypeTask, col, "i", &story)
+
+	if got := laneKey(t, repo, p, models.SwimlaneEpic, card); got != epicA {
+		t.Fatalf("card lane = %q, want %q", got, epicA)
+	}
+
+	// Re-parenting the story moves the card along and keeps its parent.
+	err := repo.Tx(ctx, func(tx interfaces.KanbanRepository) error {
+		return tx.ApplyLaneMove(ctx, p.ID, story, models.SwimlaneEpic, &epicA, &epicB)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := laneKey(t, repo, p, models.SwimlaneEpic, card); got != epicB {
+		t.Errorf("card lane = %q, want %q", got, epicB)
+	}
+
+	var parent string
+	if err := p.DB.QueryRow(ctx, `SELECT parent_issue_id FROM issues WHERE id = $1`, card).Scan(&parent); err != nil {
+		t.Fatal(err)
+	}
+	if parent != story {
+		t.Errorf("card parent = %q, want story %q", parent, story)
+	}
+}
@@ -6,159 +6,214 @@ import (
 	"bugforge-backend/internal/models"
 )
 
-func (r *KanbanRepo) GetNextOrder(ctx context.Context, columnID string) (int, error) {
-    var next int
-    err := r.exec.QueryRow(ctx,
-        `SELECT COALESCE(MAX("order") + 1, 1)
-         FROM issues WHERE column_id = $1`,
-        columnID,
-    ).Scan(&next)
-    return next, err
+// GetCardTail returns the highest rank in the column, trashed cards
+// included so that they can be restored in place, and the number of live
+// cards.
+func (r *KanbanRepo) GetCardTail(ctx context.Context, columnID string) (string, int, error) {
+    var last string
+    var count int
+    err := r.exec.QueryRow(ctx, `
+        SELECT COALESCE(MAX(rank), ''), COUNT(*) FILTER (WHERE deleted_at IS NULL)
+        FROM issues WHERE column_id = $1
+    `, columnID).Scan(&last, &count)
+    return last, count, err
 }
 
-func (r *KanbanRepo) ShiftOrders(ctx context.Context, columnID string, fromOrder int) error {
-    _, err := r.exec.Exec(ctx, `
-        UPDATE issues
-        SET "order" = "order" + 1
-        WHERE column_id = $1
-          AND "order" >= $2
-    `, columnID, fromOrder)
-    return err
+// GetCardNeighbors returns the ranks around the 1-based position pos of
+// the column, leaving out cardID. Empty strings mark the ends.
+func (r *KanbanRepo) GetCardNeighbors(ctx context.Context, columnID, cardID string, pos int) (string, string, error) {
+    var prev, next string
+    err := r.exec.QueryRow(ctx, `
+        SELECT COALESCE(MAX(rank) FILTER (WHERE n < $3), ''),
+               COALESCE(MIN(rank) FILTER (WHERE n >= $3), '')
+        FROM (
+            SELECT rank, ROW_NUMBER() OVER (ORDER BY rank, id) AS n
+            FROM issues
+            WHERE column_id = $1 AND id <> $2 AND deleted_at IS NULL
+        ) s
+    `, columnID, cardID, pos).Scan(&prev, &next)
+    return prev, next, err
 }
 
-func (r *KanbanRepo) ShiftOrdersDown(ctx context.Context, columnID string, fromOrder int) error {
-    _, err := r.exec.Exec(ctx, `
-        UPDATE issues
-        SET "order" = "order" - 1
-        WHERE column_id = $1 AND "order" > $2
-    `, columnID, fromOrder)
+// RebalanceCards respaces the ranks of every card in the column, trashed
+// ones included, keeping their order. It takes the column lock first, the
+// same lock every move into the column holds while it picks a rank.
+func (r *KanbanRepo) RebalanceCards(ctx context.Context, columnID string) error {
+    if _, err := r.LockColumn(ctx, columnID); err != nil {
+        return err
+    }
+    ids, err := r.collectIDs(ctx, `
+        SELECT id FROM issues WHERE column_id = $1
+        ORDER BY rank ASC NULLS LAST, id
+        FOR UPDATE
+    `, columnID)
+    if err != nil {
+        return err
+    }
+    _, err = r.exec.Exec(ctx, `
+        UPDATE issues i SET rank = v.rank, version = i.version + 1
+        FROM unnest($1::uuid[], $2::text[]) AS v(id, rank)
+        WHERE i.id = v.id
+    `, ids, models.RankSequence(len(ids)))
     return err
 }
 
-func (r *KanbanRepo) ShiftRangeUp(ctx context.Context, columnID string, start, end int) error {
-    _, err := r.exec.Exec(ctx, `
-        UPDATE issues SET "order" = "order" + 1
-        WHERE column_id = $1 AND "order" >= $2 AND "order" < $3
-    `, columnID, start, end)
-    return err
+// ListLongRanks returns the columns whose cards, and the boards whose
+// columns, carry ranks longer than maxLen.
+func (r *KanbanRepo) ListLongRanks(ctx context.Context, maxLen int) ([]string, []string, error) {
+    columnIDs, err := r.collectIDs(ctx, `
+        SELECT DISTINCT column_id FROM issues
+        WHERE column_id IS NOT NULL AND length(rank) > $1
+    `, maxLen)
+    if err != nil {
+        return nil, nil, err
+    }
+    boardIDs, err := r.collectIDs(ctx, `
+        SELECT DISTINCT board_id FROM kanban_columns
+        WHERE length(rank) > $1
+    `, maxLen)
+    if err != nil {
+        return nil, nil, err
+    }
+    return columnIDs, boardIDs, nil
 }
 
-func (r *KanbanRepo) ShiftRangeDown(ctx context.Context, columnID string, start, end int) error {
-    _, err := r.exec.Exec(ctx, `
-        UPDATE issues SET "order" = "order" - 1
-        WHERE column_id = $1 AND "order" <= $2 AND "order" > $3
-    `, columnID, start, end)
-    return err
+func (r *KanbanRepo) collectIDs(ctx context.Context, sql string, args ...any) ([]string, error) {
+    rows, err := r.exec.Query(ctx, sql, args...)
+    if err != nil {
+        return nil, err
+    }
+    defer rows.Close()
+
+    ids := []string{}
+    for rows.Next() {
+        var id string
+        if err := rows.Scan(&id); err != nil {
+            return nil, err
+        }
+        ids = append(ids, id)
+    }
+    return ids, rows.Err()
 }
 
-// GetColumnsWithCards loads all columns for a project and their cards.
-func (r *KanbanRepo) GetColumnsWithCards(ctx context.Context, projectID string) ([]models.KanbanColumnWithCards, error) {
+// GetColumnsWithCards loads the visible columns of a board and the cards
+// the filters select among the live cards of the project. A card sits in
+// its own column when that column is on the board, else in the board's
+// column of the same name, else in the first one; it is listed after the
+// board's own cards of that column. Cards of archived columns stay hidden.
+// With sprintID set only the cards of that sprint are returned (scrum
+// mode). CardCount always counts every live card of the column itself.
+func (r *KanbanRepo) GetColumnsWithCards(ctx context.Context, boardID string, sprintID *string, filters []models.BoardFilter) ([]models.KanbanColumnWithCards, error) {
     columns := []models.KanbanColumnWithCards{}
+    filterSQL, filterArgs := boardFilterSQL(filters, 5)
 
     // 1. Load all columns
     colRows, err := r.exec.Query(ctx, `
-        SELECT id, project_id, name, "order"
-        FROM kanban_columns
-        WHERE project_id = $1
-        ORDER BY "order" ASC
-    `, projectID)
+        SELECT c.id, c.project_id, c.board_id, c.name, ROW_NUMBER() OVER (ORDER BY c.rank, c.id), c.rank,
+               c.wip_limit, c.wip_mode,
+               (SELECT COUNT(*) FROM issues i WHERE i.column_id = c.id AND i.deleted_at IS NULL)
+        FROM kanban_columns c
+        WHERE c.board_id = $1 AND c.archived_at IS NULL
+        ORDER BY c.rank ASC, c.id
+    `, boardID)
     if err != nil {
         return nil, err
     }
-    defer colRows.Close()
-
     for colRows.Next() {
         var col models.KanbanColumnWithCards
-        if err := colRows.Scan(&col.ID, &col.ProjectID, &col.Name, &col.Order); err != nil {
-            return nil, err
-        }
-
-        // 2. Load cards for each column
-        cardRows, err := r.exec.Query(ctx, `
-            SELECT id, project_id, column_id, title, description, "order",
-                   created_by, created_at, updated_at
-            FROM issues
-            WHERE column_id = $1
-            ORDER BY "order" ASC
-        `, col.ID)
-        if err != nil {
+        if err := colRows.Scan(&col.ID, &col.ProjectID, &col.BoardID, &col.Name, &col.Order, &col.Rank, &col.WIPLimit, &col.WIPMode, &col.CardCount); err != nil {
+            colRows.Close()
             return nil, err
         }
-
-        cards := []models.Issue{}
-        for cardRows.Next() {
-            var issue models.Issue
-            if err := cardRows.Scan(
-                &issue.ID,
-                &issue.ProjectID,
-                &issue.ColumnID,
-                &issue.Title,
-                &issue.Description,
-                &issue.Order,
-                &issue.CreatedBy,
-                &issue.CreatedAt,
-                &issue.UpdatedAt,
-            ); err != nil {
-                cardRows.Close()
-                return nil, err
-            }
-            cards = append(cards, issue)
-        }
-        cardRows.Close()
-
-        col.Cards = cards
+        col.OverLimit = col.WIPLimit != nil && col.CardCount > *col.WIPLimit
+        col.Cards = []models.Issue{}
         columns = append(columns, col)
     }
+    colRows.Close()
+    if err := colRows.Err(); err != nil {
+        return nil, err
+    }
+    if len(columns) == 0 {
+        return columns, nil
+    }
 
-    return columns, nil
-}
-
-func (r *KanbanRepo) ReorderColumnsShiftUp(
-    ctx context.Context,
-    projectID string,
-    newOrder int,
-    oldOrder int,
-    columnID string,
-) error {
-
-    // Shift other columns down
-    _, err := r.exec.Exec(ctx, `
-        UPDATE kanban_columns
-        SET "order" = "order" + 1
-        WHERE project_id = $1
-          AND "order" >= $2
-          AND "order" <  $3
-          AND id != $4
-    `, projectID, newOrder, oldOrder, columnID)
+    // 2. Load the cards of the board, with their checklist/subtask rollup
+    cardRows, err := r.exec.Query(ctx, `
+        WITH cols AS (
+            SELECT id, name, rank FROM kanban_columns
+            WHERE board_id = $1 AND archived_at IS NULL
+        )
+        SELECT i.placed_in, i.id, i.project_id, i.column_id, i.title, i.description, i.pos, i.rank,
+               i.issue_type, i.parent_issue_id,
+               i.created_by, i.created_at, i.updated_at,
+               cp.done, cp.total, sp.done, sp.total
+        FROM (
+            -- Positions count every live card of the card's own column,
+            -- not just the sprint's.
+            SELECT i.*,
+                   ROW_NUMBER() OVER (PARTITION BY i.column_id ORDER BY i.rank, i.id) AS pos,
+                   COALESCE(
+                       (SELECT c.id FROM cols c WHERE c.id = i.column_id),
+                       (SELECT c.id FROM cols c WHERE lower(c.name) = lower(h.name) ORDER BY c.rank, c.id LIMIT 1),
+                       (SELECT c.id FROM cols c ORDER BY c.rank, c.id LIMIT 1)
+                   ) AS placed_in
+            FROM issues i
+            JOIN kanban_columns h ON h.id = i.column_id
+            WHERE i.project_id = $2 AND i.deleted_at IS NULL AND h.archived_at IS NULL
+        ) i
+        LEFT JOIN LATERAL (
+            SELECT COUNT(*) FILTER (WHERE it.done) AS done, COUNT(*) AS total
+            FROM checklists cl
+            JOIN checklist_items it ON it.checklist_id = cl.id
+            WHERE cl.issue_id = i.id
+        ) cp ON true
+        LEFT JOIN LATERAL (
+            SELECT COUNT(*) FILTER (WHERE s.status = ANY($3)) AS done, COUNT(*) AS total
+            FROM subtasks s
+            WHERE s.parent_issue_id = i.id
+        ) sp ON true
+        WHERE ($4::uuid IS NULL OR i.sprint_id = $4)`+filterSQL+`
+        ORDER BY i.placed_in, i.column_id = i.placed_in DESC, i.rank, i.id
+    `, append([]any{boardID, columns[0].ProjectID, models.SubtaskDoneStatuses, sprintID}, filterArgs...)...)
     if err != nil {
-        return err
+        return nil, err
     }
+    defer cardRows.Close()
 
-    // Set new order for target column
-    return r.UpdateColumnOrder(ctx, columnID, newOrder)
-}
-
-func (r *KanbanRepo) ReorderColumnsShiftDown(
-    ctx context.Context,
-    projectID string,
-    oldOrder int,
-    newOrder int,
-    columnID string,
-) error {
+    byColumn := make(map[string]int, len(columns))
+    for n := range columns {
+        byColumn[columns[n].ID] = n
+    }
 
-    // Shift other columns up
-    _, err := r.exec.Exec(ctx, `
-        UPDATE kanban_columns
-        SET "order" = "order" - 1
-        WHERE project_id = $1
-          AND "order" <= $2
-          AND "order" >  $3
-          AND id != $4
-    `, projectID, newOrder, oldOrder, columnID)
-    if err != nil {
-        return err
+    for cardRows.Next() {
+        var placedIn string
+        var issue models.Issue
+        var clDone, clTotal, stDone, stTotal int
+        if err := cardRows.Scan(
+            &placedIn,
+            &issue.ID,
+            &issue.ProjectID,
+            &issue.ColumnID,
+            &issue.Title,
+            &issue.Description,
+            &issue.Order,
+            &issue.Rank,
+            &issue.IssueType,
+            &issue.ParentIssueID,
+            &issue.CreatedBy,
+            &issue.CreatedAt,
+            &issue.UpdatedAt,
+            &clDone, &clTotal, &stDone, &stTotal,
+        ); err != nil {
+            return nil, err
+        }
+        if clTotal+stTotal > 0 {
+            issue.Progress = models.NewIssueProgress(clDone, clTotal, stDone, stTotal)
+        }
+        col := &columns[byColumn[placedIn]]
+        col.Cards = append(col.Cards, issue)
+        col.FilteredCount++
     }
 
-    // Set new order for target column
-    return r.UpdateColumnOrder(ctx, columnID, newOrder)
+    return columns, cardRows.Err()
 }
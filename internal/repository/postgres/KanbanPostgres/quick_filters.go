@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+
+	"bugforge-backend/internal/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *KanbanRepo) ListQuickFilters(ctx context.Context, projectID string) ([]models.QuickFilter, error) {
+    rows, err := r.exec.Query(ctx, `
+        SELECT id, project_id, name, filter, created_by, created_at, updated_at
+        FROM board_quick_filters
+        WHERE project_id = $1
+        ORDER BY lower(name) ASC
+    `, projectID)
+    if err != nil {
+        return nil, err
+    }
+    defer rows.Close()
+
+    out := []models.QuickFilter{}
+    for rows.Next() {
+        var qf models.QuickFilter
+        if err := rows.Scan(&qf.ID, &qf.ProjectID, &qf.Name, &qf.Filter, &qf.CreatedBy, &qf.CreatedAt, &qf.UpdatedAt); err != nil {
+            return nil, err
+        }
+        out = append(out, qf)
+    }
+    return out, rows.Err()
+}
+
+func (r *KanbanRepo) GetQuickFilter(ctx context.Context, id string) (*models.QuickFilter, error) {
+    var qf models.QuickFilter
+    err := r.exec.QueryRow(ctx, `
+        SELECT id, project_id, name, filter, created_by, created_at, updated_at
+        FROM board_quick_filters
+        WHERE id = $1
+    `, id).Scan(&qf.ID, &qf.ProjectID, &qf.Name, &qf.Filter, &qf.CreatedBy, &qf.CreatedAt, &qf.UpdatedAt)
+    if err == pgx.ErrNoRows {
+        return nil, models.ErrQuickFilterNotFound
+    }
+    if err != nil {
+        return nil, err
+    }
+    return &qf, nil
+}
+
+func (r *KanbanRepo) CreateQuickFilter(ctx context.Context, qf *models.QuickFilter) error {
+    return r.exec.QueryRow(ctx, `
+        INSERT INTO board_quick_filters (id, project_id, name, filter, created_by)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING created_at, updated_at
+    `, qf.ID, qf.ProjectID, qf.Name, qf.Filter, qf.CreatedBy).Scan(&qf.CreatedAt, &qf.UpdatedAt)
+}
+
+func (r *KanbanRepo) UpdateQuickFilter(ctx context.Context, qf *models.QuickFilter) error {
+    err := r.exec.QueryRow(ctx, `
+        UPDATE board_quick_filters
+        SET name = $2, filter = $3, updated_at = NOW()
+        WHERE id = $1
+        RETURNING updated_at
+    `, qf.ID, qf.Name, qf.Filter).Scan(&qf.UpdatedAt)
+    if err == pgx.ErrNoRows {
+        return models.ErrQuickFilterNotFound
+    }
+    return err
+}
+
+func (r *KanbanRepo) DeleteQuickFilter(ctx context.Context, id string) error {
+    tag, err := r.exec.Exec(ctx, `DELETE FROM board_quick_filters WHERE id = $1`, id)
+    if err != nil {
+        return err
+    }
+    if tag.RowsAffected() == 0 {
+        return models.ErrQuickFilterNotFound
+    }
+    return nil
+}
@@ -33,9 +33,9 @@ func (r *ActivityRepoPG) Create(ctx context.Context, a *models.IssueActivity) er
 	}
 
 	_, err := r.db.Exec(ctx,
-		`INSERT INTO issue_activity_logs (id, issue_id, user_id, action, metadata, created_at)
-		VALUES ($1, $2, $3, $4, $5, NOW())`,
-		a.ID, a.IssueID, a.UserID, a.Action, meta)
+		`INSERT INTO issue_activity_logs (id, issue_id, project_id, user_id, action, metadata, created_at)
+		VALUES ($1, NULLIF($2, '')::uuid, $3, $4, $5, $6, NOW())`,
+		a.ID, a.IssueID, a.ProjectID, a.UserID, a.Action, meta)
 
 	return err
 }
@@ -84,23 +84,23 @@ func (r *ActivityRepoPG) ListByIssue(ctx context.Context, issueID string) ([]mod
 	return out, nil
 }
 
-func (r *ActivityRepoPG) ListByProject(ctx context.Context, projectID string) ([]models.IssueActivity, error) {
+func (r *ActivityRepoPG) ListByProject(ctx context.Context, projectID string, p models.PageRequest) ([]models.IssueActivity, models.PageInfo, error) {
+	where, tail, args := keyset(p, "a.created_at", "a.id", true, 2)
+
 	rows, err := r.db.Query(ctx,
 		`SELECT 
-			a.id, a.issue_id, a.user_id, a.action, a.metadata, a.created_at,
+			a.id, COALESCE(a.issue_id::text, ''), a.project_id, a.user_id, a.action, a.metadata, a.created_at,
 			i.title AS issue_title
 		FROM issue_activity_logs a
 		LEFT JOIN issues i ON a.issue_id = i.id
-		WHERE i.project_id = $1
-		ORDER BY a.created_at DESC;
-		`,
-		projectID)
+		WHERE (i.project_id = $1 OR a.project_id = $1) AND i.deleted_at IS NULL`+where+tail,
+		append([]interface{}{projectID}, args...)...)
 	if err != nil {
-		return nil, err
+		return nil, models.PageInfo{}, err
 	}
 	defer rows.Close()
 
-	var out []models.IssueActivity
+	out := []models.IssueActivity{}
 
 	for rows.Next() {
 		var a models.IssueActivity
@@ -111,13 +111,14 @@ func (r *ActivityRepoPG) ListByProject(ctx context.Context, projectID string) ([
 		if err := rows.Scan(
 			&a.ID,
 			&a.IssueID,
+			&a.ProjectID,
 			&a.UserID,
 			&a.Action,
 			&meta,
 			&a.CreatedAt,
 			&issueTitle,
 		); err != nil {
-			return nil, err
+			return nil, models.PageInfo{}, err
 		}
 
 		a.IssueTitle = issueTitle
@@ -134,5 +135,24 @@ func (r *ActivityRepoPG) ListByProject(ctx context.Context, projectID string) ([
 		out = append(out, a)
 	}
 
-	return out, nil
+	out, info := finishPage(out, p, func(a models.IssueActivity) models.Cursor {
+		return models.Cursor{CreatedAt: a.CreatedAt, ID: a.ID}
+	})
+
+	if p.WithTotal {
+		var total int
+		err := r.db.QueryRow(ctx,
+			`SELECT COUNT(*)
+			 FROM issue_activity_logs a
+			 LEFT JOIN issues i ON a.issue_id = i.id
+			 WHERE (i.project_id = $1 OR a.project_id = $1) AND i.deleted_at IS NULL`,
+			projectID,
+		).Scan(&total)
+		if err != nil {
+			return nil, models.PageInfo{}, err
+		}
+		info.Total = &total
+	}
+
+	return out, info, nil
 }
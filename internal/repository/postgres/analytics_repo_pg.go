@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AnalyticsRepoPG struct {
+	db *pgxpool.Pool
+}
+
+func NewAnalyticsRepository(db *pgxpool.Pool) repo.AnalyticsRepository {
+	return &AnalyticsRepoPG{db: db}
+}
+
+func (r *AnalyticsRepoPG) ListFlowIssues(ctx context.Context, projectID string) ([]models.FlowIssue, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, title, status, story_points, column_id::text, sprint_id::text, created_at, deleted_at
+		FROM issues
+		WHERE project_id = $1
+		ORDER BY created_at ASC
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []models.FlowIssue{}
+	for rows.Next() {
+		var i models.FlowIssue
+		err := rows.Scan(&i.ID, &i.Title, &i.Status, &i.StoryPoints, &i.ColumnID, &i.SprintID, &i.CreatedAt, &i.DeletedAt)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, i)
+	}
+	return out, rows.Err()
+}
+
+func (r *AnalyticsRepoPG) ListColumns(ctx context.Context, projectID string) ([]models.FlowColumn, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT c.id, c.name FROM kanban_columns c
+		JOIN boards b ON b.id = c.board_id
+		WHERE c.project_id = $1
+		ORDER BY b.is_default DESC, b.created_at, c.rank ASC, c.id
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []models.FlowColumn{}
+	for rows.Next() {
+		var c models.FlowColumn
+		if err := rows.Scan(&c.ID, &c.Name); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+// ListStatusEvents reads status_changed entries and the status part of
+// bulk_updated entries.
+func (r *AnalyticsRepoPG) ListStatusEvents(ctx context.Context, projectID string) ([]models.IssueEvent, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT a.issue_id,
+			CASE WHEN a.action = $2 THEN a.metadata->>'old' ELSE a.metadata->'changes'->'status'->>'old' END,
+			CASE WHEN a.action = $2 THEN a.metadata->>'new' ELSE a.metadata->'changes'->'status'->>'new' END,
+			a.created_at
+		FROM issue_activity_logs a
+		JOIN issues i ON i.id = a.issue_id
+		WHERE i.project_id = $1
+		  AND (a.action = $2 OR (a.action = $3 AND a.metadata->'changes'->'status' IS NOT NULL))
+		ORDER BY a.created_at ASC
+	`, projectID, models.ActivityStatusChanged, models.ActivityBulkUpdated)
+	if err != nil {
+		return nil, err
+	}
+	return scanIssueEvents(rows)
+}
+
+func (r *AnalyticsRepoPG) ListSprintEvents(ctx context.Context, sprintID string) ([]models.IssueEvent, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT issue_id, metadata->>'old', metadata->>'new', created_at
+		FROM issue_activity_logs
+		WHERE action = $1
+		  AND (metadata->>'old' = $2 OR metadata->>'new' = $2)
+		ORDER BY created_at ASC
+	`, models.ActivitySprintChanged, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	return scanIssueEvents(rows)
+}
+
+func (r *AnalyticsRepoPG) ListColumnMoves(ctx context.Context, projectID string) ([]models.IssueEvent, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT m.issue_id, m.from_column_id::text, m.to_column_id::text, m.moved_at
+		FROM issue_column_moves m
+		JOIN issues i ON i.id = m.issue_id
+		WHERE i.project_id = $1
+		ORDER BY m.moved_at ASC
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return scanIssueEvents(rows)
+}
+
+func scanIssueEvents(rows pgx.Rows) ([]models.IssueEvent, error) {
+	defer rows.Close()
+
+	out := []models.IssueEvent{}
+	for rows.Next() {
+		var e models.IssueEvent
+		if err := rows.Scan(&e.IssueID, &e.Old, &e.New, &e.At); err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,268 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ChecklistTemplateRepoPG struct {
+	db *pgxpool.Pool
+}
+
+func NewChecklistTemplateRepository(db *pgxpool.Pool) repo.ChecklistTemplateRepository {
+	return &ChecklistTemplateRepoPG{db: db}
+}
+
+const checklistTemplateSelect = `
+	SELECT t.id, t.customer_id, t.project_id, t.name, t.current_version,
+		v.title, v.items, t.created_by, t.created_at, t.updated_at
+	FROM checklist_templates t
+	JOIN checklist_template_versions v
+		ON v.template_id = t.id AND v.version = t.current_version
+`
+
+func scanChecklistTemplate(row pgx.Row) (*models.ChecklistTemplate, error) {
+	var t models.ChecklistTemplate
+	err := row.Scan(
+		&t.ID, &t.CustomerID, &t.ProjectID, &t.Name, &t.Version,
+		&t.Title, &t.Items, &t.CreatedBy, &t.CreatedAt, &t.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *ChecklistTemplateRepoPG) CreateTemplate(ctx context.Context, t *models.ChecklistTemplate) error {
+	if t.ID == "" {
+		t.ID = uuid.NewString()
+	}
+	if t.Items == nil {
+		t.Items = []string{}
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO checklist_templates (id, customer_id, project_id, name, current_version, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, 1, $5, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`, t.ID, t.CustomerID, t.ProjectID, t.Name, t.CreatedBy).Scan(&t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO checklist_template_versions (template_id, version, title, items, created_by, created_at)
+		VALUES ($1, 1, $2, $3, $4, NOW())
+	`, t.ID, t.Title, t.Items, t.CreatedBy)
+	if err != nil {
+		return err
+	}
+
+	t.Version = 1
+	return tx.Commit(ctx)
+}
+
+func (r *ChecklistTemplateRepoPG) UpdateTemplate(ctx context.Context, t *models.ChecklistTemplate, editorID string) error {
+	if t.Items == nil {
+		t.Items = []string{}
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var (
+		current int
+		title   string
+		items   []string
+	)
+	err = tx.QueryRow(ctx, `
+		SELECT t.current_version, v.title, v.items
+		FROM checklist_templates t
+		JOIN checklist_template_versions v
+			ON v.template_id = t.id AND v.version = t.current_version
+		WHERE t.id = $1
+		FOR UPDATE OF t
+	`, t.ID).Scan(&current, &title, &items)
+	if err == pgx.ErrNoRows {
+		return models.ErrChecklistTemplateNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	version := current
+	if title != t.Title || !sameItems(items, t.Items) {
+		version = current + 1
+		_, err = tx.Exec(ctx, `
+			INSERT INTO checklist_template_versions (template_id, version, title, items, created_by, created_at)
+			VALUES ($1, $2, $3, $4, $5, NOW())
+		`, t.ID, version, t.Title, t.Items, editorID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.QueryRow(ctx, `
+		UPDATE checklist_templates
+		SET name = $2, current_version = $3, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`, t.ID, t.Name, version).Scan(&t.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	t.Version = version
+	return tx.Commit(ctx)
+}
+
+func (r *ChecklistTemplateRepoPG) DeleteTemplate(ctx context.Context, templateID string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM checklist_templates WHERE id=$1`, templateID)
+	return err
+}
+
+func (r *ChecklistTemplateRepoPG) GetTemplateByID(ctx context.Context, templateID string) (*models.ChecklistTemplate, error) {
+	t, err := scanChecklistTemplate(r.db.QueryRow(ctx, checklistTemplateSelect+` WHERE t.id = $1`, templateID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return t, err
+}
+
+func (r *ChecklistTemplateRepoPG) ListTemplatesByProject(ctx context.Context, projectID string) ([]models.ChecklistTemplate, error) {
+	rows, err := r.db.Query(ctx, checklistTemplateSelect+` WHERE t.project_id = $1 ORDER BY t.name ASC`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []models.ChecklistTemplate
+	for rows.Next() {
+		t, err := scanChecklistTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *t)
+	}
+	return out, rows.Err()
+}
+
+func (r *ChecklistTemplateRepoPG) ListVersions(ctx context.Context, templateID string) ([]models.ChecklistTemplateVersion, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT template_id, version, title, items, created_by, created_at
+		FROM checklist_template_versions
+		WHERE template_id = $1
+		ORDER BY version DESC
+	`, templateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []models.ChecklistTemplateVersion
+	for rows.Next() {
+		var v models.ChecklistTemplateVersion
+		if err := rows.Scan(&v.TemplateID, &v.Version, &v.Title, &v.Items, &v.CreatedBy, &v.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, rows.Err()
+}
+
+func (r *ChecklistTemplateRepoPG) Apply(ctx context.Context, templateID string, version int, issueID string) (*models.Checklist, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var (
+		title string
+		items []string
+	)
+	err = tx.QueryRow(ctx, `
+		SELECT title, items FROM checklist_template_versions
+		WHERE template_id = $1 AND version = $2
+	`, templateID, version).Scan(&title, &items)
+	if err == pgx.ErrNoRows {
+		return nil, errors.New("template version not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cl := &models.Checklist{
+		ID:              uuid.NewString(),
+		IssueID:         issueID,
+		Title:           title,
+		TemplateID:      &templateID,
+		TemplateVersion: &version,
+		Items:           []models.ChecklistItem{},
+	}
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO checklists (id, issue_id, title, template_id, template_version, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING created_at
+	`, cl.ID, cl.IssueID, cl.Title, templateID, version).Scan(&cl.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, content := range items {
+		it := models.ChecklistItem{
+			ID:          uuid.NewString(),
+			ChecklistID: cl.ID,
+			Content:     content,
+			OrderIndex:  i,
+			Version:     1,
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO checklist_items (id, checklist_id, content, done, order_index)
+			VALUES ($1, $2, $3, FALSE, $4)
+		`, it.ID, it.ChecklistID, it.Content, it.OrderIndex)
+		if err != nil {
+			return nil, err
+		}
+		cl.Items = append(cl.Items, it)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return cl, nil
+}
+
+func sameItems(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
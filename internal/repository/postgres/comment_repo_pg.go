@@ -44,7 +44,7 @@ func (r *CommentRepoPG) ListCommentsByIssue(ctx context.Context, issueID string)
 			u.email AS author_email
 		FROM issue_comments c
 		LEFT JOIN users u ON u.id = c.user_id
-		WHERE c.issue_id = $1
+		WHERE c.issue_id = $1 AND c.deleted_at IS NULL
 		ORDER BY c.created_at ASC;`,
 		issueID,
 	)
@@ -0,0 +1,238 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// BulkApply applies ch to every issue of the project in one transaction.
+// Each issue runs inside its own savepoint, so a failing item is rolled
+// back and reported without aborting the rest of the batch.
+func (r *IssueRepoPG) BulkApply(ctx context.Context, projectID string, issueIDs []string, ch models.BulkIssueChange, actorID string) ([]models.BulkItemResult, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if ch.ColumnID != nil {
+		var ok bool
+		err := tx.QueryRow(ctx,
+			`SELECT EXISTS (SELECT 1 FROM kanban_columns WHERE id = $1 AND project_id = $2 AND archived_at IS NULL)`,
+			*ch.ColumnID, projectID,
+		).Scan(&ok)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errors.New("column does not belong to this project or is archived")
+		}
+	}
+
+	results := make([]models.BulkItemResult, 0, len(issueIDs))
+
+	for _, id := range issueIDs {
+		res := models.BulkItemResult{IssueID: id}
+
+		sp, err := tx.Begin(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := bulkApplyOne(ctx, sp, projectID, id, ch, actorID, &res); err != nil {
+			_ = sp.Rollback(ctx)
+			res.Error = err.Error()
+			res.Changes = nil
+			res.WIPWarning = nil
+		} else {
+			if err := sp.Commit(ctx); err != nil {
+				return nil, err
+			}
+			res.OK = true
+		}
+
+		results = append(results, res)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func bulkApplyOne(ctx context.Context, tx pgx.Tx, projectID, id string, ch models.BulkIssueChange, actorID string, res *models.BulkItemResult) error {
+	var (
+		status, priority string
+		assignedTo       *string
+		dueDate          *time.Time
+		columnID         *string
+	)
+
+	err := tx.QueryRow(ctx, `
+		SELECT title, status, priority, assigned_to, due_date, column_id
+		FROM issues
+		WHERE id = $1 AND project_id = $2 AND deleted_at IS NULL
+		FOR UPDATE
+	`, id, projectID).Scan(&res.Title, &status, &priority, &assignedTo, &dueDate, &columnID)
+	if err == pgx.ErrNoRows {
+		return errors.New("issue not found")
+	}
+	if err != nil {
+		return err
+	}
+
+	if assignedTo != nil {
+		res.Assignees = append(res.Assignees, *assignedTo)
+	}
+	res.Changes = map[string]models.FieldChange{}
+
+	if ch.Delete {
+		if err := SoftDeleteIssue(ctx, tx, id, actorID); err != nil {
+			return err
+		}
+		res.Changes["deleted"] = models.FieldChange{Old: false, New: true}
+		return nil
+	}
+
+	newStatus, newPriority := status, priority
+	newAssignee, newDue := assignedTo, dueDate
+
+	if ch.Status != nil && *ch.Status != status {
+		newStatus = *ch.Status
+		res.Changes["status"] = models.FieldChange{Old: status, New: newStatus}
+	}
+	if ch.Priority != nil && *ch.Priority != priority {
+		newPriority = *ch.Priority
+		res.Changes["priority"] = models.FieldChange{Old: priority, New: newPriority}
+	}
+	if ch.AssignedTo.Set {
+		newAssignee = nil
+		if !ch.AssignedTo.Null {
+			v := ch.AssignedTo.Value
+			newAssignee = &v
+		}
+		if !models.SameID(assignedTo, newAssignee) {
+			res.Changes["assigned_to"] = models.FieldChange{Old: assignedTo, New: newAssignee}
+			if newAssignee != nil {
+				res.Assignees = append(res.Assignees, *newAssignee)
+			}
+		}
+	}
+	if ch.DueDate.Set {
+		newDue = nil
+		if !ch.DueDate.Null {
+			v := ch.DueDate.Value
+			newDue = &v
+		}
+		if !sameTime(dueDate, newDue) {
+			res.Changes["due_date"] = models.FieldChange{Old: dueDate, New: newDue}
+		}
+	}
+
+	if len(res.Changes) > 0 {
+		_, err = tx.Exec(ctx, `
+			UPDATE issues
+			SET status = $2, priority = $3, assigned_to = $4, due_date = $5,
+				version = version + 1, updated_at = NOW()
+			WHERE id = $1
+		`, id, newStatus, newPriority, newAssignee, newDue)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Column move: append to the new column, within its WIP limit.
+	if ch.ColumnID != nil && !models.SameID(columnID, ch.ColumnID) {
+		w, err := AdmitCards(ctx, tx, *ch.ColumnID, 1)
+		if err != nil {
+			return err
+		}
+		res.WIPWarning = w
+		rank, _, err := appendCardRank(ctx, tx, *ch.ColumnID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE issues
+			SET column_id = $2, rank = $3, version = version + 1, updated_at = NOW()
+			WHERE id = $1
+		`, id, *ch.ColumnID, rank)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO issue_column_moves (id, issue_id, from_column_id, to_column_id, moved_at)
+			VALUES ($1, $2, $3, $4, NOW())
+		`, uuid.NewString(), id, columnID, *ch.ColumnID)
+		if err != nil {
+			return err
+		}
+		res.Changes["column_id"] = models.FieldChange{Old: columnID, New: *ch.ColumnID}
+	}
+
+	if len(ch.AddLabels) > 0 {
+		added, err := collectIDs(tx.Query(ctx, `
+			INSERT INTO issue_labels (issue_id, label_id)
+			SELECT $1::uuid, l.id FROM labels l
+			WHERE l.id = ANY($2) AND l.project_id = $3
+			ON CONFLICT DO NOTHING
+			RETURNING label_id::text
+		`, id, ch.AddLabels, projectID))
+		if err != nil {
+			return err
+		}
+		if len(added) > 0 {
+			res.Changes["labels_added"] = models.FieldChange{New: added}
+		}
+	}
+
+	if len(ch.RemoveLabels) > 0 {
+		removed, err := collectIDs(tx.Query(ctx, `
+			DELETE FROM issue_labels
+			WHERE issue_id = $1 AND label_id = ANY($2)
+			RETURNING label_id::text
+		`, id, ch.RemoveLabels))
+		if err != nil {
+			return err
+		}
+		if len(removed) > 0 {
+			res.Changes["labels_removed"] = models.FieldChange{Old: removed}
+		}
+	}
+
+	return nil
+}
+
+func collectIDs(rows pgx.Rows, err error) ([]string, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+//
+// ─────────────────────────────────────────────────────────────
+//   HIERARCHY (EPIC > STORY > TASK)
+// ─────────────────────────────────────────────────────────────
+//
+
+const hierarchyColumns = `
+	i.id, i.project_id, COALESCE(i.column_id::text, ''), ` + cardPosition + `,
+	i.title, i.description, i.status, i.priority,
+	i.issue_type, i.parent_issue_id, i.story_points,
+	i.original_estimate_minutes, i.remaining_estimate_minutes, i.created_by,
+	i.assigned_to, i.due_date, i.custom_fields, i.version, i.created_at, i.updated_at
+`
+
+// ListAncestors walks parent_issue_id upwards. The depth cap stops the walk
+// should a cycle ever slip into the data.
+func (r *IssueRepoPG) ListAncestors(ctx context.Context, issueID string) ([]models.Issue, error) {
+	rows, err := r.db.Query(ctx, `
+		WITH RECURSIVE a (id, depth) AS (
+			SELECT parent_issue_id, 1 FROM issues
+			WHERE id = $1 AND parent_issue_id IS NOT NULL
+			UNION
+			SELECT p.parent_issue_id, a.depth + 1
+			FROM issues p JOIN a ON p.id = a.id
+			WHERE p.parent_issue_id IS NOT NULL AND a.depth < 100
+		)
+		SELECT `+hierarchyColumns+`
+		FROM a JOIN issues i ON i.id = a.id
+		WHERE i.deleted_at IS NULL
+		ORDER BY a.depth ASC
+	`, issueID)
+	if err != nil {
+		return nil, err
+	}
+	return scanHierarchy(rows)
+}
+
+// lockParentChain runs inside the tx that sets parentID as the parent of
+// issueID. It locks the issue, then walks up from parentID locking every
+// ancestor FOR UPDATE, and refuses the parent with models.ErrIssueCycle
+// when issueID is on that chain. Two re-parentings touching the same chain
+// so run one after the other, and the later one sees the earlier one's
+// parent; the service's ListAncestors check alone could let both through.
+func lockParentChain(ctx context.Context, tx pgx.Tx, issueID, parentID string) error {
+	var current *string
+	err := tx.QueryRow(ctx,
+		`SELECT parent_issue_id FROM issues WHERE id = $1 FOR UPDATE`, issueID,
+	).Scan(&current)
+	if err == pgx.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if current != nil && *current == parentID {
+		return nil
+	}
+
+	// Same depth cap as ListAncestors
+	id := parentID
+	for depth := 0; depth < 100; depth++ {
+		if id == issueID {
+			return models.ErrIssueCycle
+		}
+		var next *string
+		err := tx.QueryRow(ctx,
+			`SELECT parent_issue_id FROM issues WHERE id = $1 FOR UPDATE`, id,
+		).Scan(&next)
+		if err == pgx.ErrNoRows || (err == nil && next == nil) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		id = *next
+	}
+	return models.ErrIssueCycle
+}
+
+func (r *IssueRepoPG) ListDescendants(ctx context.Context, issueID string) ([]models.Issue, error) {
+	rows, err := r.db.Query(ctx, `
+		WITH RECURSIVE d (id) AS (
+			SELECT id FROM issues WHERE parent_issue_id = $1 AND deleted_at IS NULL
+			UNION
+			SELECT c.id FROM issues c JOIN d ON c.parent_issue_id = d.id
+			WHERE c.deleted_at IS NULL
+		)
+		SELECT `+hierarchyColumns+`
+		FROM d JOIN issues i ON i.id = d.id
+		ORDER BY i.created_at ASC, i.id ASC
+	`, issueID)
+	if err != nil {
+		return nil, err
+	}
+	return scanHierarchy(rows)
+}
+
+func scanHierarchy(rows pgx.Rows) ([]models.Issue, error) {
+	defer rows.Close()
+
+	out := []models.Issue{}
+	for rows.Next() {
+		var i models.Issue
+		err := rows.Scan(
+			&i.ID, &i.ProjectID, &i.ColumnID, &i.Order,
+			&i.Title, &i.Description, &i.Status, &i.Priority,
+			&i.IssueType, &i.ParentIssueID, &i.StoryPoints,
+			&i.OriginalEstimate, &i.RemainingEstimate, &i.CreatedBy,
+			&i.AssignedTo, &i.DueDate, &i.CustomFields, &i.Version, &i.CreatedAt, &i.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, i)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// cardPosition derives the 1-based kanban position of issue i from the
+// ranks of the live cards in its column (0 when it is off the board).
+const cardPosition = `(
+	SELECT COUNT(*) FROM issues o
+	WHERE o.column_id = i.column_id AND o.deleted_at IS NULL
+	  AND (o.rank, o.id) <= (i.rank, i.id)
+)`
+
+// appendCardRank returns the rank and position of a card appended to the
+// column. Trashed cards count towards the rank so they can be restored in
+// place.
+func appendCardRank(ctx context.Context, tx pgx.Tx, columnID string) (string, int, error) {
+	var last string
+	var count int
+	err := tx.QueryRow(ctx, `
+		SELECT COALESCE(MAX(rank), ''), COUNT(*) FILTER (WHERE deleted_at IS NULL)
+		FROM issues WHERE column_id = $1
+	`, columnID).Scan(&last, &count)
+	if err != nil {
+		return "", 0, err
+	}
+	return models.RankAfter(last), count + 1, nil
+}
+
+// AdmitCards locks the column row for the rest of tx and checks that n more
+// cards fit in. Archived columns and hard WIP limits refuse them
+// (models.ErrColumnArchived, models.ErrWIPLimitExceeded); past a soft limit
+// they get in and the warning for the hub is returned. Every write adding
+// cards to a column, here and in the kanban repository, goes through this,
+// so writes into one column are counted one after the other.
+func AdmitCards(ctx context.Context, tx Querier, columnID string, n int) (*models.WIPWarning, error) {
+	var (
+		name     string
+		limit    *int
+		mode     string
+		archived bool
+	)
+	err := tx.QueryRow(ctx, `
+		SELECT name, wip_limit, wip_mode, archived_at IS NOT NULL
+		FROM kanban_columns WHERE id = $1
+		FOR UPDATE
+	`, columnID).Scan(&name, &limit, &mode, &archived)
+	if err == pgx.ErrNoRows {
+		return nil, errors.New("column not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if archived {
+		return nil, models.ErrColumnArchived
+	}
+	if limit == nil || n == 0 {
+		return nil, nil
+	}
+
+	var count int
+	err = tx.QueryRow(ctx,
+		`SELECT COUNT(*) FROM issues WHERE column_id = $1 AND deleted_at IS NULL`,
+		columnID,
+	).Scan(&count)
+	if err != nil {
+		return nil, err
+	}
+	if count+n <= *limit {
+		return nil, nil
+	}
+	if mode == models.WIPModeHard {
+		return nil, models.ErrWIPLimitExceeded
+	}
+
+	return &models.WIPWarning{
+		ColumnID:   columnID,
+		ColumnName: name,
+		Limit:      *limit,
+		Count:      count + n,
+	}, nil
+}
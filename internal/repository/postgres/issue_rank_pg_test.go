@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	"bugforge-backend/internal/repository/postgres/pgtest"
+	"context"
+	"errors"
+	"testing"
+)
+
+// admit runs AdmitCards for n cards in its own transaction.
+func admit(t *testing.T, p *pgtest.Project, columnID string, n int) (*models.WIPWarning, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	tx, err := p.DB.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	return AdmitCards(ctx, tx, columnID, n)
+}
+
+func TestAdmitCardsWIPLimits(t *testing.T) {
+	p := pgtest.NewProject(t)
+
+	// Each column holds two cards under a limit of two, or none at all.
+	tests := []struct {
+		name        string
+		limit       *int
+		mode        string
+		n           int
+		wantErr     error
+		wantWarning bool
+	}{
+		{name: "unlimited", mode: models.WIPModeSoft, n: 1},
+		{name: "nothing to add", limit: ptr(2), mode: models.WIPModeHard, n: 0},
+		{name: "soft limit warns", limit: ptr(2), mode: models.WIPModeSoft, n: 1, wantWarning: true},
+		{name: "hard limit rejects", limit: ptr(2), mode: models.WIPModeHard, n: 1, wantErr: models.ErrWIPLimitExceeded},
+		{name: "batch counted as a whole", limit: ptr(3), mode: models.WIPModeHard, n: 2, wantErr: models.ErrWIPLimitExceeded},
+		{name: "batch that fits", limit: ptr(4), mode: models.WIPModeHard, n: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columnID := p.Column(tt.name)
+			p.Issue(models.IssueTypeTask, columnID, "1", nil)
+			p.Issue(models.IssueTypeTask, columnID, "2", nil)
+			p.Exec(`UPDATE kanban_columns SET wip_limit = $2, wip_mode = $3 WHERE id = $1`, columnID, tt.limit, tt.mode)
+
+			w, err := admit(t, p, columnID, tt.n)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if !tt.wantWarning {
+				if w != nil {
+					t.Fatalf("unexpected warning %+v", *w)
+				}
+				return
+			}
+			if w == nil {
+				t.Fatal("no warning past the soft limit")
+			}
+			want := models.WIPWarning{ColumnID: columnID, ColumnName: tt.name, Limit: *tt.limit, Count: 2 + tt.n}
+			if *w != want {
+				t.Errorf("warning = %+v, want %+v", *w, want)
+			}
+		})
+	}
+}
+
+func TestAdmitCardsTrashedCardsDoNotCount(t *testing.T) {
+	p := pgtest.NewProject(t)
+	columnID := p.Column("Todo")
+	p.Exec(`UPDATE kanban_columns SET wip_limit = 1, wip_mode = $2 WHERE id = $1`, columnID, models.WIPModeHard)
+
+	card := p.Issue(models.IssueTypeTask, columnID, "1", nil)
+	p.Exec(`UPDATE issues SET deleted_at = NOW() WHERE id = $1`, card)
+
+	if _, err := admit(t, p, columnID, 1); err != nil {
+		t.Fatalf("trashed card counted towards the limit: %v", err)
+	}
+}
+
+func TestAdmitCardsArchivedColumn(t *testing.T) {
+	p := pgtest.NewProject(t)
+	columnID := p.Column("Done")
+	p.Exec(`UPDATE kanban_columns SET archived_at = NOW() WHERE id = $1`, columnID)
+
+	if _, err := admit(t, p, columnID, 1); !errors.Is(err, models.ErrColumnArchived) {
+		t.Fatalf("err = %v, want %v", err, models.ErrColumnArchived)
+	}
+}
+
+func ptr(n int) *int { return &n }
@@ -4,11 +4,13 @@ import (
 	"bugforge-backend/internal/models"
 	repo "bugforge-backend/internal/repository/interfaces"
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -28,64 +30,153 @@ func NewIssueRepository(db *pgxpool.Pool) repo.IssueRepository {
 
 func (r *IssueRepoPG) Create(ctx context.Context, i *models.Issue) error {
 	query := `
-		INSERT INTO issues (id, project_id, title, description, status, priority, created_by, assigned_to, due_date, created_at, updated_at)
-		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,NOW(),NOW())
+		INSERT INTO issues (id, project_id, title, description, status, priority, issue_type, parent_issue_id, story_points,
+			created_by, assigned_to, due_date, custom_fields, created_at, updated_at)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,NOW(),NOW())
 
 	`
 	if i.ID == "" {
 		i.ID = uuid.NewString()
 	}
+	if i.CustomFields == nil {
+		i.CustomFields = map[string]interface{}{}
+	}
+	if i.IssueType == "" {
+		i.IssueType = models.IssueTypeTask
+	}
 	_, err := r.db.Exec(ctx, query,
 		i.ID, i.ProjectID, i.Title, i.Description,
-		i.Status, i.Priority, i.CreatedBy, i.AssignedTo,
-		i.DueDate,
+		i.Status, i.Priority, i.IssueType, i.ParentIssueID, i.StoryPoints,
+		i.CreatedBy, i.AssignedTo, i.DueDate, i.CustomFields,
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	i.Version = 1
+	return nil
 }
 
-func (r *IssueRepoPG) ListAll(ctx context.Context, customerID string) ([]models.IssueWithUser, error) {
+// insertIssueTx inserts issue inside tx. With a column it is appended to
+// that kanban column, otherwise it stays off the board. It returns the soft
+// WIP limit warning of the column, if any.
+func insertIssueTx(ctx context.Context, tx pgx.Tx, issue *models.Issue, column *string) (*models.WIPWarning, error) {
+	if issue.ID == "" {
+		issue.ID = uuid.NewString()
+	}
+	if issue.CustomFields == nil {
+		issue.CustomFields = map[string]interface{}{}
+	}
+	if issue.IssueType == "" {
+		issue.IssueType = models.IssueTypeTask
+	}
+
+	var (
+		rank    *string
+		warning *models.WIPWarning
+	)
+	if column != nil {
+		w, err := AdmitCards(ctx, tx, *column, 1)
+		if err != nil {
+			return nil, err
+		}
+		warning = w
+		r, order, err := appendCardRank(ctx, tx, *column)
+		if err != nil {
+			return nil, err
+		}
+		rank = &r
+		issue.ColumnID = *column
+		issue.Order = order
+		issue.Rank = r
+	}
+
+	err := tx.QueryRow(ctx, `
+		INSERT INTO issues (id, project_id, column_id, rank, title, description, status, priority,
+			issue_type, parent_issue_id, story_points,
+			created_by, assigned_to, due_date, custom_fields, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`,
+		issue.ID, issue.ProjectID, column, rank, issue.Title, issue.Description,
+		issue.Status, issue.Priority, issue.IssueType, issue.ParentIssueID, issue.StoryPoints,
+		issue.CreatedBy, issue.AssignedTo, issue.DueDate, issue.CustomFields,
+	).Scan(&issue.CreatedAt, &issue.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	issue.Version = 1
+	return warning, nil
+}
+
+func (r *IssueRepoPG) ListAll(ctx context.Context, customerID string, p models.PageRequest) ([]models.IssueWithUser, models.PageInfo, error) {
+	where, tail, args := keyset(p, "i.created_at", "i.id", true, 2)
+
 	query := `
         SELECT i.id, i.project_id, i.title, i.description, i.status, i.priority,
+               i.issue_type, i.parent_issue_id, i.story_points, i.sprint_id, i.fix_version_id,
                i.created_by, cu.email AS created_by_email, cu.name AS created_by_name,
                i.assigned_to, au.email AS assigned_to_email, au.name AS assigned_to_name,
                i.created_at, i.updated_at
         FROM issues i
         LEFT JOIN users cu ON cu.id = i.created_by
         LEFT JOIN users au ON au.id = i.assigned_to
-        WHERE cu.customer_id = $1
-	`
+        WHERE cu.customer_id = $1 AND i.deleted_at IS NULL
+	` + where + tail
 
-	rows, err := r.db.Query(ctx, query, customerID)
+	rows, err := r.db.Query(ctx, query, append([]interface{}{customerID}, args...)...)
 	if err != nil {
-		return nil, err
+		return nil, models.PageInfo{}, err
 	}
 	defer rows.Close()
 
-	var out []models.IssueWithUser
+	out := []models.IssueWithUser{}
 
 	for rows.Next() {
 		var i models.IssueWithUser
 		err := rows.Scan(
 			&i.ID, &i.ProjectID, &i.Title, &i.Description, &i.Status, &i.Priority,
+			&i.IssueType, &i.ParentIssueID, &i.StoryPoints, &i.SprintID, &i.FixVersionID,
 			&i.CreatedBy, &i.CreatedByEmail, &i.CreatedByName,
 			&i.AssignedTo, &i.AssignedToEmail, &i.AssignedToName,
 			&i.CreatedAt, &i.UpdatedAt,
 		)
 		if err != nil {
-			return nil, err
+			return nil, models.PageInfo{}, err
 		}
 		out = append(out, i)
 	}
 
-	return out, nil
+	out, info := finishPage(out, p, func(i models.IssueWithUser) models.Cursor {
+		return models.Cursor{CreatedAt: i.CreatedAt, ID: i.ID}
+	})
+
+	if p.WithTotal {
+		var total int
+		err := r.db.QueryRow(ctx, `
+			SELECT COUNT(*)
+			FROM issues i
+			JOIN users cu ON cu.id = i.created_by
+			WHERE cu.customer_id = $1 AND i.deleted_at IS NULL
+		`, customerID).Scan(&total)
+		if err != nil {
+			return nil, models.PageInfo{}, err
+		}
+		info.Total = &total
+	}
+
+	return out, info, nil
 }
 
 func (r *IssueRepoPG) GetByID(ctx context.Context, id string) (*models.Issue, error) {
 	query := `
-		SELECT id, project_id, title, description, status, priority, created_by,
-			assigned_to, due_date, created_at, updated_at
-		FROM issues WHERE id=$1 LIMIT 1
+		SELECT id, project_id, title, description, status, priority,
+			issue_type, parent_issue_id, story_points, sprint_id, backlog_order, fix_version_id,
+			original_estimate_minutes, remaining_estimate_minutes, created_by,
+			assigned_to, due_date, custom_fields, version, moved_from_project_id,
+			created_at, updated_at
+		FROM issues WHERE id=$1 AND deleted_at IS NULL LIMIT 1
 
 	`
 
@@ -93,8 +184,10 @@ func (r *IssueRepoPG) GetByID(ctx context.Context, id string) (*models.Issue, er
 
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&i.ID, &i.ProjectID, &i.Title, &i.Description, &i.Status,
-		&i.Priority, &i.CreatedBy, &i.AssignedTo, &i.DueDate,
-		&i.CreatedAt, &i.UpdatedAt,
+		&i.Priority, &i.IssueType, &i.ParentIssueID, &i.StoryPoints, &i.SprintID, &i.BacklogOrder, &i.FixVersionID,
+		&i.OriginalEstimate, &i.RemainingEstimate,
+		&i.CreatedBy, &i.AssignedTo, &i.DueDate,
+		&i.CustomFields, &i.Version, &i.MovedFromProjectID, &i.CreatedAt, &i.UpdatedAt,
 
 	)
 	if err == pgx.ErrNoRows {
@@ -107,13 +200,14 @@ func (r *IssueRepoPG) ListByProject(ctx context.Context, projectID string, f rep
 	baseQuery := `
         SELECT 
             i.id, i.project_id, i.title, i.description, i.status, i.priority,
+            i.issue_type, i.parent_issue_id, i.story_points, i.sprint_id, i.fix_version_id,
             i.created_by, cb.email AS created_by_email, cb.name AS created_by_name,
             i.assigned_to, ab.email AS assigned_to_email, ab.name AS assigned_to_name,
             i.created_at, i.updated_at
         FROM issues i
         LEFT JOIN users cb ON cb.id = i.created_by
         LEFT JOIN users ab ON ab.id = i.assigned_to
-        WHERE i.project_id = $1
+        WHERE i.project_id = $1 AND i.deleted_at IS NULL
 	`
 
 	params := []interface{}{projectID}
@@ -134,13 +228,62 @@ func (r *IssueRepoPG) ListByProject(ctx context.Context, projectID string, f rep
 		params = append(params, *f.AssignedTo)
 		idx++
 	}
-	if f.Search != nil {
-		baseQuery += fmt.Sprintf(" AND (i.title ILIKE $%d OR i.description ILIKE $%d)", idx, idx)
-		params = append(params, "%"+*f.Search+"%")
+	if f.IssueType != nil {
+		baseQuery += fmt.Sprintf(" AND i.issue_type = $%d", idx)
+		params = append(params, *f.IssueType)
+		idx++
+	}
+	if f.Backlog {
+		baseQuery += " AND i.sprint_id IS NULL"
+	} else if f.SprintID != nil {
+		baseQuery += fmt.Sprintf(" AND i.sprint_id = $%d", idx)
+		params = append(params, *f.SprintID)
+		idx++
+	}
+	if f.FixVersionID != nil {
+		baseQuery += fmt.Sprintf(" AND i.fix_version_id = $%d", idx)
+		params = append(params, *f.FixVersionID)
+		idx++
+	}
+	if f.EpicID != nil {
+		// Any depth below the epic.
+		baseQuery += fmt.Sprintf(`
+		AND i.id IN (
+			WITH RECURSIVE d AS (
+				SELECT id FROM issues WHERE parent_issue_id = $%[1]d AND deleted_at IS NULL
+				UNION
+				SELECT c.id FROM issues c JOIN d ON c.parent_issue_id = d.id WHERE c.deleted_at IS NULL
+			)
+			SELECT id FROM d
+		)`, idx)
+		params = append(params, *f.EpicID)
 		idx++
 	}
+	if f.Search != nil {
+		// Full-text match on the issue, its comments or attachment filenames,
+		// with a trigram match on the title to tolerate typos.
+		baseQuery += fmt.Sprintf(`
+		AND (
+			i.search_vector @@ to_tsquery('english', $%[1]d)
+			OR i.title %% $%[2]d
+			OR EXISTS (
+				SELECT 1 FROM issue_comments c
+				WHERE c.issue_id = i.id AND c.deleted_at IS NULL AND c.search_vector @@ to_tsquery('english', $%[1]d)
+			)
+			OR EXISTS (
+				SELECT 1 FROM issue_attachments a
+				WHERE a.issue_id = i.id AND a.search_vector @@ to_tsquery('simple', $%[1]d)
+			)
+		)`, idx, idx+1)
+		params = append(params, ToPrefixTSQuery(*f.Search), *f.Search)
+		idx += 2
+	}
 
-	baseQuery += fmt.Sprintf(" ORDER BY i.%s %s", f.SortBy, f.Direction)
+	orderBy, params, idx, err := issueOrderBy(f.Sort, params, idx)
+	if err != nil {
+		return nil, err
+	}
+	baseQuery += orderBy
 
 	baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", idx, idx+1)
 	params = append(params, f.Limit, f.Offset)
@@ -157,6 +300,7 @@ func (r *IssueRepoPG) ListByProject(ctx context.Context, projectID string, f rep
 		err := rows.Scan(
 			&i.ID, &i.ProjectID, &i.Title, &i.Description,
 			&i.Status, &i.Priority,
+			&i.IssueType, &i.ParentIssueID, &i.StoryPoints, &i.SprintID, &i.FixVersionID,
 			&i.CreatedBy, &i.CreatedByEmail, &i.CreatedByName,
 			&i.AssignedTo, &i.AssignedToEmail, &i.AssignedToName,
 			&i.CreatedAt, &i.UpdatedAt,
@@ -170,22 +314,302 @@ func (r *IssueRepoPG) ListByProject(ctx context.Context, projectID string, f rep
 	return out, nil
 }
 
-func (r *IssueRepoPG) Update(ctx context.Context, i *models.Issue) error {
+// Update writes i and bumps its version. With expectedVersion set it only
+// applies while the stored version still equals it; a lost race yields
+// models.ErrVersionConflict.
+func (r *IssueRepoPG) Update(ctx context.Context, i *models.Issue, expectedVersion *int) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if i.ParentIssueID != nil {
+		if err := lockParentChain(ctx, tx, i.ID, *i.ParentIssueID); err != nil {
+			return err
+		}
+	}
+
 	query := `
 		UPDATE issues
-		SET title=$1, description=$2, status=$3, priority=$4, assigned_to=$5, due_date=$6, updated_at=NOW()
-		WHERE id=$7
+		SET title=$1, description=$2, status=$3, priority=$4, assigned_to=$5, due_date=$6,
+			custom_fields=COALESCE($7, custom_fields), issue_type=$10, parent_issue_id=$11, story_points=$12,
+			original_estimate_minutes=$13, remaining_estimate_minutes=$14,
+			version=version+1, updated_at=NOW()
+		WHERE id=$8 AND deleted_at IS NULL AND ($9::int IS NULL OR version=$9)
+		RETURNING version, updated_at
+	`
+	err = tx.QueryRow(ctx, query,
+		i.Title, i.Description, i.Status, i.Priority, i.AssignedTo, i.DueDate, i.CustomFields, i.ID, expectedVersion,
+		i.IssueType, i.ParentIssueID, i.StoryPoints,
+		i.OriginalEstimate, i.RemainingEstimate,
+	).Scan(&i.Version, &i.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return models.ErrVersionConflict
+	}
+	if err != nil {
+		return err
+	}
 
+	return tx.Commit(ctx)
+}
+
+func (r *IssueRepoPG) Delete(ctx context.Context, id, deletedBy string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := SoftDeleteIssue(ctx, tx, id, deletedBy); err != nil && err != pgx.ErrNoRows {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Querier is the part of a transaction SoftDeleteIssue needs; pgx.Tx and the
+// KanbanPostgres executors both satisfy it.
+type Querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// SoftDeleteIssue trashes a live issue inside tx. It returns pgx.ErrNoRows
+// when the issue does not exist or is already in the trash.
+func SoftDeleteIssue(ctx context.Context, tx Querier, id, deletedBy string) error {
+	var deletedAt time.Time
+
+	// The card keeps its column_id and rank so Restore can put it back in
+	// place; the other cards of the column are not touched.
+	err := tx.QueryRow(ctx, `
+		UPDATE issues
+		SET deleted_at = NOW(), deleted_by = $2, version = version + 1
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING deleted_at
+	`, id, deletedBy).Scan(&deletedAt)
+	if err != nil {
+		return err
+	}
+
+	// Comments share the issue's deleted_at so Restore can tell them apart
+	// from comments that were deleted on their own.
+	_, err = tx.Exec(ctx, `
+		UPDATE issue_comments
+		SET deleted_at = $2, deleted_by = $3
+		WHERE issue_id = $1 AND deleted_at IS NULL
+	`, id, deletedAt, deletedBy)
+	return err
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   TRASH
+// ─────────────────────────────────────────────────────────────
+//
+
+func (r *IssueRepoPG) GetDeletedByID(ctx context.Context, id string) (*models.Issue, error) {
+	query := `
+		SELECT id, project_id, title, description, status, priority,
+			issue_type, parent_issue_id, story_points, created_by,
+			assigned_to, due_date, custom_fields, version, created_at, updated_at, deleted_at, deleted_by
+		FROM issues WHERE id=$1 AND deleted_at IS NOT NULL LIMIT 1
 	`
-	_, err := r.db.Exec(ctx, query,
-		i.Title, i.Description, i.Status, i.Priority, i.AssignedTo, i.DueDate, i.ID,
+
+	var i models.Issue
+
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&i.ID, &i.ProjectID, &i.Title, &i.Description, &i.Status,
+		&i.Priority, &i.IssueType, &i.ParentIssueID, &i.StoryPoints, &i.CreatedBy, &i.AssignedTo, &i.DueDate,
+		&i.CustomFields, &i.Version, &i.CreatedAt, &i.UpdatedAt, &i.DeletedAt, &i.DeletedBy,
 	)
-	return err
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return &i, err
 }
 
-func (r *IssueRepoPG) Delete(ctx context.Context, id string) error {
-	_, err := r.db.Exec(ctx, `DELETE FROM issues WHERE id=$1`, id)
-	return err
+func (r *IssueRepoPG) Restore(ctx context.Context, id string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var projectID string
+	var columnID, rank *string
+	var deletedAt time.Time
+
+	err = tx.QueryRow(ctx, `
+		SELECT project_id, column_id, rank, deleted_at
+		FROM issues
+		WHERE id = $1 AND deleted_at IS NOT NULL
+		FOR UPDATE
+	`, id).Scan(&projectID, &columnID, &rank, &deletedAt)
+	if err == pgx.ErrNoRows {
+		return models.ErrNotInTrash
+	}
+	if err != nil {
+		return err
+	}
+
+	// A card that still has a rank but lost its column_id was in a column
+	// that got deleted while it sat in the trash (DetachTrashedCards).
+	removed := columnID == nil && rank != nil
+	if columnID != nil {
+		err = tx.QueryRow(ctx,
+			`SELECT NOT EXISTS (SELECT 1 FROM kanban_columns WHERE id = $1)`, *columnID,
+		).Scan(&removed)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The column is gone: fall back to the end of the first column of the
+	// project's default board.
+	if removed {
+		columnID, rank = nil, nil
+		err = tx.QueryRow(ctx, `
+			SELECT c.id FROM kanban_columns c
+			JOIN boards b ON b.id = c.board_id
+			WHERE c.project_id = $1 AND c.archived_at IS NULL
+			ORDER BY b.is_default DESC, b.created_at, c.rank ASC, c.id
+			LIMIT 1
+		`, projectID).Scan(&columnID)
+		if err != nil && err != pgx.ErrNoRows {
+			return err
+		}
+	}
+
+	// A card that kept its rank slots back between its old neighbours;
+	// one without goes to the end of the column. Either way the hard WIP
+	// limit applies; an archived column takes its card back regardless.
+	if columnID != nil {
+		if _, err := AdmitCards(ctx, tx, *columnID, 1); err != nil && !errors.Is(err, models.ErrColumnArchived) {
+			return err
+		}
+	}
+	if columnID != nil && rank == nil {
+		r, _, err := appendCardRank(ctx, tx, *columnID)
+		if err != nil {
+			return err
+		}
+		rank = &r
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE issues
+		SET deleted_at = NULL, deleted_by = NULL,
+			column_id = $2, rank = $3, version = version + 1, updated_at = NOW()
+		WHERE id = $1
+	`, id, columnID, rank)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE issue_comments
+		SET deleted_at = NULL, deleted_by = NULL
+		WHERE issue_id = $1 AND deleted_at = $2
+	`, id, deletedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *IssueRepoPG) ListTrash(ctx context.Context, projectID string) ([]models.TrashItem, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT 'issue' AS type, i.id::text AS id, NULL::text AS issue_id, i.title AS title,
+			i.deleted_at, i.deleted_by::text AS deleted_by, u.name AS deleted_by_name
+		FROM issues i
+		LEFT JOIN users u ON u.id = i.deleted_by
+		WHERE i.project_id = $1 AND i.deleted_at IS NOT NULL
+
+		UNION ALL
+
+		SELECT 'comment' AS type, c.id::text AS id, c.issue_id::text AS issue_id, left(c.body, 140) AS title,
+			c.deleted_at, c.deleted_by::text AS deleted_by, u.name AS deleted_by_name
+		FROM issue_comments c
+		JOIN issues i ON i.id = c.issue_id
+		LEFT JOIN users u ON u.id = c.deleted_by
+		WHERE i.project_id = $1 AND i.deleted_at IS NULL AND c.deleted_at IS NOT NULL
+
+		ORDER BY deleted_at DESC
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []models.TrashItem{}
+	for rows.Next() {
+		var t models.TrashItem
+		if err := rows.Scan(
+			&t.Type, &t.ID, &t.IssueID, &t.Title,
+			&t.DeletedAt, &t.DeletedBy, &t.DeletedByName,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+
+	return out, rows.Err()
+}
+
+func (r *IssueRepoPG) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	comments, err := tx.Exec(ctx,
+		`DELETE FROM issue_comments WHERE deleted_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+
+	// Subtasks, checklists and relations went to the trash with their
+	// issue; they go before it so no foreign key holds the purge back.
+	for _, q := range []string{
+		`DELETE FROM checklist_items WHERE checklist_id IN (
+			SELECT cl.id FROM checklists cl JOIN issues i ON i.id = cl.issue_id
+			WHERE i.deleted_at < $1)`,
+		`DELETE FROM checklists WHERE issue_id IN (
+			SELECT id FROM issues WHERE deleted_at < $1)`,
+		`DELETE FROM subtasks WHERE parent_issue_id IN (
+			SELECT id FROM issues WHERE deleted_at < $1)`,
+		`DELETE FROM issue_relations WHERE issue_id IN (
+			SELECT id FROM issues WHERE deleted_at < $1
+		) OR related_issue_id IN (
+			SELECT id FROM issues WHERE deleted_at < $1)`,
+	} {
+		if _, err := tx.Exec(ctx, q, before); err != nil {
+			return 0, err
+		}
+	}
+
+	issues, err := tx.Exec(ctx,
+		`DELETE FROM issues WHERE deleted_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return comments.RowsAffected() + issues.RowsAffected(), nil
 }
 
 //
@@ -204,19 +628,24 @@ func (r *IssueRepoPG) CreateComment(ctx context.Context, c *models.IssueComment)
 		VALUES ($1,$2,$3,$4,NOW())
 	`
 	_, err := r.db.Exec(ctx, query, c.ID, c.IssueID, c.UserID, c.Body)
-	return err
+	if err != nil {
+		return err
+	}
+
+	c.Version = 1
+	return nil
 }
 
 func (r *IssueRepoPG) GetCommentByID(ctx context.Context, id string) (*models.IssueComment, error) {
 	query := `
-		SELECT id, issue_id, user_id, body, created_at
-		FROM issue_comments WHERE id = $1
+		SELECT id, issue_id, user_id, body, body_html, version, created_at, updated_at
+		FROM issue_comments WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	var c models.IssueComment
 
 	err := r.db.QueryRow(ctx, query, id).Scan(
-		&c.ID, &c.IssueID, &c.UserID, &c.Body, &c.CreatedAt,
+		&c.ID, &c.IssueID, &c.UserID, &c.Body, &c.BodyHTML, &c.Version, &c.CreatedAt, &c.UpdatedAt,
 	)
 	if err == pgx.ErrNoRows {
 		return nil, nil
@@ -224,22 +653,65 @@ func (r *IssueRepoPG) GetCommentByID(ctx context.Context, id string) (*models.Is
 	return &c, err
 }
 
-func (r *IssueRepoPG) UpdateComment(ctx context.Context, c *models.IssueComment) error {
+// UpdateComment is conditional on c.Version like Update.
+func (r *IssueRepoPG) UpdateComment(ctx context.Context, c *models.IssueComment, expectedVersion *int) error {
 	query := `
 		UPDATE issue_comments
-		SET body=$1
-		WHERE id=$2
+		SET body=$1, body_html=$2, version=version+1, updated_at=NOW()
+		WHERE id=$3 AND deleted_at IS NULL AND ($4::int IS NULL OR version=$4)
+		RETURNING version, updated_at
 	`
-	_, err := r.db.Exec(ctx, query, c.Body, c.ID)
+	err := r.db.QueryRow(ctx, query, c.Body, c.BodyHTML, c.ID, expectedVersion).Scan(&c.Version, &c.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return models.ErrVersionConflict
+	}
 	return err
 }
 
-func (r *IssueRepoPG) DeleteComment(ctx context.Context, id string) error {
-	_, err := r.db.Exec(ctx, `DELETE FROM issue_comments WHERE id=$1`, id)
+func (r *IssueRepoPG) DeleteComment(ctx context.Context, id, deletedBy string) error {
+	_, err := r.db.Exec(ctx, `
+		UPDATE issue_comments
+		SET deleted_at = NOW(), deleted_by = $2
+		WHERE id = $1 AND deleted_at IS NULL
+	`, id, deletedBy)
 	return err
 }
 
-func (r *IssueRepoPG) ListCommentsByIssue(ctx context.Context, issueID string) ([]models.IssueComment, error) {
+func (r *IssueRepoPG) GetDeletedCommentByID(ctx context.Context, id string) (*models.IssueComment, error) {
+	query := `
+		SELECT id, issue_id, user_id, body, created_at, deleted_at, deleted_by
+		FROM issue_comments WHERE id = $1 AND deleted_at IS NOT NULL
+	`
+
+	var c models.IssueComment
+
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&c.ID, &c.IssueID, &c.UserID, &c.Body, &c.CreatedAt, &c.DeletedAt, &c.DeletedBy,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return &c, err
+}
+
+func (r *IssueRepoPG) RestoreComment(ctx context.Context, id string) error {
+	tag, err := r.db.Exec(ctx, `
+		UPDATE issue_comments
+		SET deleted_at = NULL, deleted_by = NULL
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return models.ErrNotInTrash
+	}
+	return nil
+}
+
+func (r *IssueRepoPG) ListCommentsByIssue(ctx context.Context, issueID string, p models.PageRequest) ([]models.IssueComment, models.PageInfo, error) {
+	where, tail, args := keyset(p, "c.created_at", "c.id", false, 2)
+
 	query := `
 		SELECT 
 			c.id,
@@ -247,23 +719,23 @@ func (r *IssueRepoPG) ListCommentsByIssue(ctx context.Context, issueID string) (
 			c.user_id,
 			c.body,
 			c.body_html,
+			c.version,
 			c.created_at,
 			c.updated_at,
 			u.name AS author_name,
 			u.email AS author_email
 		FROM issue_comments c
 		LEFT JOIN users u ON u.id = c.user_id
-		WHERE c.issue_id = $1
-		ORDER BY c.created_at ASC;
-	`
+		WHERE c.issue_id = $1 AND c.deleted_at IS NULL
+	` + where + tail
 
-	rows, err := r.db.Query(ctx, query, issueID)
+	rows, err := r.db.Query(ctx, query, append([]interface{}{issueID}, args...)...)
 	if err != nil {
-		return nil, err
+		return nil, models.PageInfo{}, err
 	}
 	defer rows.Close()
 
-	var out []models.IssueComment
+	out := []models.IssueComment{}
 
 	for rows.Next() {
 		var c models.IssueComment
@@ -274,6 +746,7 @@ func (r *IssueRepoPG) ListCommentsByIssue(ctx context.Context, issueID string) (
 			&c.UserID,
 			&c.Body,
 			&c.BodyHTML,
+			&c.Version,
 			&c.CreatedAt,
 			&c.UpdatedAt,
 			&c.AuthorName,
@@ -281,13 +754,28 @@ func (r *IssueRepoPG) ListCommentsByIssue(ctx context.Context, issueID string) (
 		)
 
 		if err != nil {
-			return nil, err
+			return nil, models.PageInfo{}, err
 		}
 
 		out = append(out, c)
 	}
 
-	return out, nil
+	out, info := finishPage(out, p, func(c models.IssueComment) models.Cursor {
+		return models.Cursor{CreatedAt: c.CreatedAt, ID: c.ID}
+	})
+
+	if p.WithTotal {
+		var total int
+		err := r.db.QueryRow(ctx,
+			`SELECT COUNT(*) FROM issue_comments WHERE issue_id = $1 AND deleted_at IS NULL`, issueID,
+		).Scan(&total)
+		if err != nil {
+			return nil, models.PageInfo{}, err
+		}
+		info.Total = &total
+	}
+
+	return out, info, nil
 }
 
 //
@@ -380,19 +868,56 @@ func (r *IssueRepoPG) CreateChecklistItem(ctx context.Context, it *models.Checkl
 	`,
 		it.ID, it.ChecklistID, it.Content, it.Done, it.OrderIndex,
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	it.Version = 1
+	return nil
 }
 
-func (r *IssueRepoPG) UpdateChecklistItem(ctx context.Context, it *models.ChecklistItem) error {
-	_, err := r.db.Exec(ctx, `
+func (r *IssueRepoPG) GetChecklistByID(ctx context.Context, id string) (*models.Checklist, error) {
+	var cl models.Checklist
+	err := r.db.QueryRow(ctx, `
+		SELECT cl.id, cl.issue_id, cl.title, cl.created_at
+		FROM checklists cl
+		JOIN issues i ON i.id = cl.issue_id AND i.deleted_at IS NULL
+		WHERE cl.id=$1
+	`, id).Scan(&cl.ID, &cl.IssueID, &cl.Title, &cl.CreatedAt)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return &cl, err
+}
+
+func (r *IssueRepoPG) GetChecklistItemByID(ctx context.Context, id string) (*models.ChecklistItem, error) {
+	var it models.ChecklistItem
+	err := r.db.QueryRow(ctx, `
+		SELECT it.id, it.checklist_id, it.content, it.done, it.order_index, it.version
+		FROM checklist_items it
+		JOIN checklists cl ON cl.id = it.checklist_id
+		JOIN issues i ON i.id = cl.issue_id AND i.deleted_at IS NULL
+		WHERE it.id=$1
+	`, id).Scan(&it.ID, &it.ChecklistID, &it.Content, &it.Done, &it.OrderIndex, &it.Version)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return &it, err
+}
+
+// UpdateChecklistItem is conditional on it.Version like Update.
+func (r *IssueRepoPG) UpdateChecklistItem(ctx context.Context, it *models.ChecklistItem, expectedVersion *int) error {
+	err := r.db.QueryRow(ctx, `
 		UPDATE checklist_items
-		SET content=$1, done=$2, order_index=$3
-		WHERE id=$4
+		SET content=$1, done=$2, order_index=$3, version=version+1
+		WHERE id=$4 AND ($5::int IS NULL OR version=$5)
+		RETURNING version
 	`,
-		it.Content, it.Done, it.OrderIndex, it.ID,
-	)
-
+		it.Content, it.Done, it.OrderIndex, it.ID, expectedVersion,
+	).Scan(&it.Version)
+	if err == pgx.ErrNoRows {
+		return models.ErrVersionConflict
+	}
 	return err
 }
 
@@ -402,11 +927,14 @@ func (r *IssueRepoPG) ListChecklistsByIssue(ctx context.Context, issueID string)
 		    cl.id AS checklist_id,
 		    cl.issue_id,
 		    cl.title,
+		    cl.template_id,
+		    cl.template_version,
 		    cl.created_at,
 		    it.id AS item_id,
 		    it.content,
 		    it.done,
-		    it.order_index
+		    it.order_index,
+		    it.version
 		FROM checklists cl
 		LEFT JOIN checklist_items it 
 		    ON it.checklist_id = cl.id
@@ -425,14 +953,17 @@ func (r *IssueRepoPG) ListChecklistsByIssue(ctx context.Context, issueID string)
 	for rows.Next() {
 		var (
 			clID, issID, title string
+			templateID         *string
+			templateVersion    *int
 			createdAt          time.Time
 			itemID             *string
 			content            *string
 			done               *bool
 			orderIndex         *int
+			version            *int
 		)
 
-		err := rows.Scan(&clID, &issID, &title, &createdAt, &itemID, &content, &done, &orderIndex)
+		err := rows.Scan(&clID, &issID, &title, &templateID, &templateVersion, &createdAt, &itemID, &content, &done, &orderIndex, &version)
 		if err != nil {
 			return nil, err
 		}
@@ -442,6 +973,8 @@ func (r *IssueRepoPG) ListChecklistsByIssue(ctx context.Context, issueID string)
 				ID:        clID,
 				IssueID:   issID,
 				Title:     title,
+				TemplateID:      templateID,
+				TemplateVersion: templateVersion,
 				CreatedAt: createdAt,
 				Items:     []models.ChecklistItem{},
 			}
@@ -455,6 +988,7 @@ func (r *IssueRepoPG) ListChecklistsByIssue(ctx context.Context, issueID string)
 				Content:    *content,
 				Done:       *done,
 				OrderIndex: *orderIndex,
+				Version:    *version,
 			})
 		}
 	}
@@ -517,10 +1051,11 @@ func (r *IssueRepoPG) CreateSubtask(ctx context.Context, s *models.Subtask) erro
 
 func (r *IssueRepoPG) GetSubtaskByID(ctx context.Context, id string) (*models.Subtask, error) {
     query := `
-        SELECT id, parent_issue_id, title, description, status,
-               assigned_to, due_date, order_index, created_at
-        FROM subtasks
-        WHERE id = $1
+        SELECT s.id, s.parent_issue_id, s.title, s.description, s.status,
+               s.assigned_to, s.due_date, s.order_index, s.created_at
+        FROM subtasks s
+        JOIN issues i ON i.id = s.parent_issue_id AND i.deleted_at IS NULL
+        WHERE s.id = $1
     `
 
     var s models.Subtask
@@ -607,9 +1142,10 @@ func (r *IssueRepoPG) CreateRelation(ctx context.Context, rel *models.IssueRelat
 
 func (r *IssueRepoPG) ListRelations(ctx context.Context, issueID string) ([]models.IssueRelation, error) {
 	query := `
-		SELECT id, issue_id, related_issue_id, relation_type, created_at
-		FROM issue_relations
-		WHERE issue_id=$1
+		SELECT r.id, r.issue_id, r.related_issue_id, r.relation_type, r.created_at
+		FROM issue_relations r
+		JOIN issues ri ON ri.id = r.related_issue_id AND ri.deleted_at IS NULL
+		WHERE r.issue_id=$1
 	`
 
 	rows, err := r.db.Query(ctx, query, issueID)
@@ -642,9 +1178,93 @@ func (r *IssueRepoPG) DeleteRelation(ctx context.Context, id string) error {
 func (r *IssueRepoPG) UpdateDueDate(ctx context.Context, issueID string, dueDate *time.Time) error {
 	query := `
 		UPDATE issues 
-		SET due_date=$1, updated_at=NOW()
-		WHERE id=$2
+		SET due_date=$1, version=version+1, updated_at=NOW()
+		WHERE id=$2 AND deleted_at IS NULL
 	`
 	_, err := r.db.Exec(ctx, query, dueDate, issueID)
 	return err
 }
+
+//
+// ─────────────────────────────────────────────────────────────
+//   PROGRESS
+// ─────────────────────────────────────────────────────────────
+//
+
+// GetProgress aggregates checklist items and subtasks for many issues in a
+// single query. Issues without either are omitted from the map.
+func (r *IssueRepoPG) GetProgress(ctx context.Context, issueIDs []string) (map[string]*models.IssueProgress, error) {
+	out := map[string]*models.IssueProgress{}
+	if len(issueIDs) == 0 {
+		return out, nil
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT i.id::text,
+			COALESCE(cp.done, 0), COALESCE(cp.total, 0),
+			COALESCE(sp.done, 0), COALESCE(sp.total, 0)
+		FROM issues i
+		LEFT JOIN (
+			SELECT cl.issue_id,
+				COUNT(*) FILTER (WHERE it.done) AS done,
+				COUNT(*) AS total
+			FROM checklists cl
+			JOIN checklist_items it ON it.checklist_id = cl.id
+			WHERE cl.issue_id = ANY($1)
+			GROUP BY cl.issue_id
+		) cp ON cp.issue_id = i.id
+		LEFT JOIN (
+			SELECT parent_issue_id,
+				COUNT(*) FILTER (WHERE status = ANY($2)) AS done,
+				COUNT(*) AS total
+			FROM subtasks
+			WHERE parent_issue_id = ANY($1)
+			GROUP BY parent_issue_id
+		) sp ON sp.parent_issue_id = i.id
+		WHERE i.id = ANY($1) AND (cp.total > 0 OR sp.total > 0)
+	`, issueIDs, models.SubtaskDoneStatuses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		var clDone, clTotal, stDone, stTotal int
+		if err := rows.Scan(&id, &clDone, &clTotal, &stDone, &stTotal); err != nil {
+			return nil, err
+		}
+		out[id] = models.NewIssueProgress(clDone, clTotal, stDone, stTotal)
+	}
+	return out, rows.Err()
+}
+
+// GetTimeSpent sums logged worklog minutes per issue. Issues without
+// worklogs are omitted from the map.
+func (r *IssueRepoPG) GetTimeSpent(ctx context.Context, issueIDs []string) (map[string]int, error) {
+	out := map[string]int{}
+	if len(issueIDs) == 0 {
+		return out, nil
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT issue_id::text, SUM(minutes)
+		FROM worklogs
+		WHERE issue_id = ANY($1)
+		GROUP BY issue_id
+	`, issueIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		var minutes int
+		if err := rows.Scan(&id, &minutes); err != nil {
+			return nil, err
+		}
+		out[id] = minutes
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	repo "bugforge-backend/internal/repository/interfaces"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type sortColumn struct {
+	expr      string
+	nullsLast bool
+}
+
+// issueSortColumns maps the public sort keys onto SQL expressions. Only keys
+// present here (or custom.<key>) ever reach the ORDER BY clause.
+var issueSortColumns = map[string]sortColumn{
+	"created_at": {expr: "i.created_at"},
+	"updated_at": {expr: "i.updated_at"},
+	"priority": {expr: `CASE i.priority
+		WHEN 'low' THEN 1
+		WHEN 'medium' THEN 2
+		WHEN 'high' THEN 3
+		WHEN 'critical' THEN 4
+		ELSE 0 END`},
+	"due_date": {expr: "i.due_date", nullsLast: true},
+	"title":    {expr: "lower(i.title)"},
+	"assignee": {expr: "lower(ab.name)", nullsLast: true},
+}
+
+var customFieldKey = regexp.MustCompile(`^[A-Za-z0-9_]{1,64}$`)
+
+// issueOrderBy renders the ORDER BY clause for sorts. Custom field keys are
+// bound as parameters starting at idx; the returned idx is the next free one.
+func issueOrderBy(sorts []repo.IssueSort, params []interface{}, idx int) (string, []interface{}, int, error) {
+	if len(sorts) == 0 {
+		sorts = []repo.IssueSort{{Field: "created_at", Desc: true}}
+	}
+
+	parts := make([]string, 0, len(sorts)+1)
+	for _, s := range sorts {
+		var col sortColumn
+
+		if key, ok := strings.CutPrefix(s.Field, repo.CustomFieldSortPrefix); ok {
+			if !customFieldKey.MatchString(key) {
+				return "", nil, idx, fmt.Errorf("invalid custom field %q", key)
+			}
+			col = sortColumn{expr: fmt.Sprintf("i.custom_fields ->> $%d", idx), nullsLast: true}
+			params = append(params, key)
+			idx++
+		} else if c, ok := issueSortColumns[s.Field]; ok {
+			col = c
+		} else {
+			return "", nil, idx, fmt.Errorf("unknown sort field %q", s.Field)
+		}
+
+		dir := "ASC"
+		if s.Desc {
+			dir = "DESC"
+		}
+
+		clause := col.expr + " " + dir
+		if col.nullsLast {
+			clause += " NULLS LAST"
+		}
+
+		parts = append(parts, clause)
+	}
+
+	// Stable tiebreaker so pages never overlap.
+	parts = append(parts, "i.id ASC")
+
+	return " ORDER BY " + strings.Join(parts, ", "), params, idx, nil
+}
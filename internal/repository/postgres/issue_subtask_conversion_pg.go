@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+//
+// ─────────────────────────────────────────────────────────────
+//   SUBTASK <-> ISSUE CONVERSION
+// ─────────────────────────────────────────────────────────────
+//
+
+// PromoteSubtask replaces the subtask with issue, places it on the board
+// and relates it to the former parent in both directions. It returns the
+// soft WIP limit warning of the column the issue lands in, if any.
+func (r *IssueRepoPG) PromoteSubtask(ctx context.Context, subtaskID string, issue *models.Issue, columnID *string) (*models.WIPWarning, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var parentID string
+	err = tx.QueryRow(ctx, `
+		SELECT s.parent_issue_id FROM subtasks s
+		JOIN issues i ON i.id = s.parent_issue_id AND i.deleted_at IS NULL
+		WHERE s.id = $1
+		FOR UPDATE OF s
+	`, subtaskID).Scan(&parentID)
+	if err == pgx.ErrNoRows {
+		return nil, errors.New("subtask not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	column, err := targetColumn(ctx, tx, issue.ProjectID, columnID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	warning, err := insertIssueTx(ctx, tx, issue, column)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO issue_relations (id, issue_id, related_issue_id, relation_type, created_at)
+		VALUES ($1, $2, $3, $4, NOW()), ($5, $3, $2, $6, NOW())
+	`,
+		uuid.NewString(), issue.ID, parentID, models.RelationSubtaskOf,
+		uuid.NewString(), models.RelationParentOf,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM subtasks WHERE id = $1`, subtaskID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return warning, nil
+}
+
+// DemoteToSubtask appends sub to its parent and moves the issue to the
+// trash, so its comments and history stay recoverable.
+func (r *IssueRepoPG) DemoteToSubtask(ctx context.Context, issueID string, sub *models.Subtask, deletedBy string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var hasSubtasks bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM subtasks WHERE parent_issue_id = $1)
+	`, issueID).Scan(&hasSubtasks)
+	if err != nil {
+		return err
+	}
+	if hasSubtasks {
+		return errors.New("issue has subtasks of its own; promote or remove them first")
+	}
+
+	if sub.ID == "" {
+		sub.ID = uuid.NewString()
+	}
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO subtasks (id, parent_issue_id, title, description, status, assigned_to, due_date, order_index, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7,
+			(SELECT COALESCE(MAX(order_index) + 1, 0) FROM subtasks WHERE parent_issue_id = $2),
+			NOW())
+		RETURNING order_index, created_at
+	`,
+		sub.ID, sub.ParentIssueID, sub.Title, sub.Description, sub.Status,
+		sub.AssignedTo, sub.DueDate,
+	).Scan(&sub.OrderIndex, &sub.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	if err := SoftDeleteIssue(ctx, tx, issueID, deletedBy); err != nil {
+		if err == pgx.ErrNoRows {
+			return errors.New("issue not found")
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
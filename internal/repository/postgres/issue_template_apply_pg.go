@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// CreateFromTemplate inserts issue together with the labels, checklists and
+// subtasks of tpl in a single transaction. When issue.ColumnID is set the
+// issue is appended to that kanban column and the column's soft WIP limit
+// warning, if any, is returned.
+func (r *IssueRepoPG) CreateFromTemplate(ctx context.Context, issue *models.Issue, tpl *models.IssueTemplate) (*models.WIPWarning, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var column *string
+	if issue.ColumnID != "" {
+		column = &issue.ColumnID
+	}
+
+	warning, err := insertIssueTx(ctx, tx, issue, column)
+	if err != nil {
+		return nil, err
+	}
+
+	// Labels deleted since the template was saved are skipped.
+	if len(tpl.LabelIDs) > 0 {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO issue_labels (issue_id, label_id)
+			SELECT $1::uuid, id FROM labels WHERE id = ANY($2) AND project_id = $3
+			ON CONFLICT DO NOTHING
+		`, issue.ID, tpl.LabelIDs, issue.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, cl := range tpl.Checklists {
+		checklistID := uuid.NewString()
+		_, err = tx.Exec(ctx, `
+			INSERT INTO checklists (id, issue_id, title, created_at) VALUES ($1, $2, $3, NOW())
+		`, checklistID, issue.ID, cl.Title)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, content := range cl.Items {
+			_, err = tx.Exec(ctx, `
+				INSERT INTO checklist_items (id, checklist_id, content, done, order_index)
+				VALUES ($1, $2, $3, FALSE, $4)
+			`, uuid.NewString(), checklistID, content, i)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for i, st := range tpl.Subtasks {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO subtasks (id, parent_issue_id, title, description, status, order_index, created_at)
+			VALUES ($1, $2, $3, $4, 'open', $5, NOW())
+		`, uuid.NewString(), issue.ID, st.Title, st.Description, i)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return warning, nil
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type IssueTemplateRepoPG struct {
+	db *pgxpool.Pool
+}
+
+func NewIssueTemplateRepository(db *pgxpool.Pool) repo.IssueTemplateRepository {
+	return &IssueTemplateRepoPG{db: db}
+}
+
+const issueTemplateColumns = `
+	id, customer_id, project_id, name, title_prefix, description, priority,
+	assigned_to, label_ids::text[], checklists, subtasks, custom_fields,
+	created_by, created_at, updated_at
+`
+
+func scanIssueTemplate(row pgx.Row) (*models.IssueTemplate, error) {
+	var t models.IssueTemplate
+	err := row.Scan(
+		&t.ID, &t.CustomerID, &t.ProjectID, &t.Name, &t.TitlePrefix, &t.Description, &t.Priority,
+		&t.AssignedTo, &t.LabelIDs, &t.Checklists, &t.Subtasks, &t.CustomFields,
+		&t.CreatedBy, &t.CreatedAt, &t.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *IssueTemplateRepoPG) CreateTemplate(ctx context.Context, t *models.IssueTemplate) error {
+	normalizeTemplate(t)
+	return r.db.QueryRow(ctx, `
+		INSERT INTO issue_templates (id, customer_id, project_id, name, title_prefix, description, priority,
+			assigned_to, label_ids, checklists, subtasks, custom_fields, created_by, created_at, updated_at)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,NOW(),NOW())
+		RETURNING created_at, updated_at
+	`,
+		t.ID, t.CustomerID, t.ProjectID, t.Name, t.TitlePrefix, t.Description, t.Priority,
+		t.AssignedTo, t.LabelIDs, t.Checklists, t.Subtasks, t.CustomFields, t.CreatedBy,
+	).Scan(&t.CreatedAt, &t.UpdatedAt)
+}
+
+func (r *IssueTemplateRepoPG) UpdateTemplate(ctx context.Context, t *models.IssueTemplate) error {
+	normalizeTemplate(t)
+	return r.db.QueryRow(ctx, `
+		UPDATE issue_templates
+		SET name=$2, title_prefix=$3, description=$4, priority=$5, assigned_to=$6,
+			label_ids=$7, checklists=$8, subtasks=$9, custom_fields=$10, updated_at=NOW()
+		WHERE id=$1
+		RETURNING updated_at
+	`,
+		t.ID, t.Name, t.TitlePrefix, t.Description, t.Priority, t.AssignedTo,
+		t.LabelIDs, t.Checklists, t.Subtasks, t.CustomFields,
+	).Scan(&t.UpdatedAt)
+}
+
+func (r *IssueTemplateRepoPG) DeleteTemplate(ctx context.Context, templateID string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM issue_templates WHERE id=$1`, templateID)
+	return err
+}
+
+func (r *IssueTemplateRepoPG) GetTemplateByID(ctx context.Context, templateID string) (*models.IssueTemplate, error) {
+	t, err := scanIssueTemplate(r.db.QueryRow(ctx,
+		`SELECT `+issueTemplateColumns+` FROM issue_templates WHERE id=$1`, templateID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return t, err
+}
+
+func (r *IssueTemplateRepoPG) ListTemplatesByProject(ctx context.Context, projectID string) ([]models.IssueTemplate, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+issueTemplateColumns+` FROM issue_templates WHERE project_id=$1 ORDER BY name ASC`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []models.IssueTemplate
+	for rows.Next() {
+		t, err := scanIssueTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *t)
+	}
+	return out, rows.Err()
+}
+
+// normalizeTemplate replaces nil collections so the NOT NULL columns get
+// empty values instead of NULL.
+func normalizeTemplate(t *models.IssueTemplate) {
+	if t.LabelIDs == nil {
+		t.LabelIDs = []string{}
+	}
+	if t.Checklists == nil {
+		t.Checklists = []models.TemplateChecklist{}
+	}
+	if t.Subtasks == nil {
+		t.Subtasks = []models.TemplateSubtask{}
+	}
+	if t.CustomFields == nil {
+		t.CustomFields = map[string]interface{}{}
+	}
+}
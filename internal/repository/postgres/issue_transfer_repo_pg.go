@@ -0,0 +1,375 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+//
+// ─────────────────────────────────────────────────────────────
+//   MOVE / CLONE ACROSS PROJECTS
+// ─────────────────────────────────────────────────────────────
+//
+
+// MoveToProject re-keys the issue to the target project. Comments,
+// checklists, subtasks and attachments hang off the issue id and follow it;
+// the board position and labels are remapped onto the target project.
+func (r *IssueRepoPG) MoveToProject(ctx context.Context, issueID, targetProjectID string, columnID *string, clearAssignee bool) (*models.MoveIssueResult, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var (
+		fromProjectID string
+		oldColumnID   *string
+	)
+	err = tx.QueryRow(ctx, `
+		SELECT project_id, column_id
+		FROM issues
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
+	`, issueID).Scan(&fromProjectID, &oldColumnID)
+	if err == pgx.ErrNoRows {
+		return nil, errors.New("issue not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if fromProjectID == targetProjectID {
+		return nil, errors.New("issue already belongs to this project")
+	}
+
+	target, err := targetColumn(ctx, tx, targetProjectID, columnID, oldColumnID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rank    *string
+		warning *models.WIPWarning
+	)
+	if target != nil {
+		warning, err = AdmitCards(ctx, tx, *target, 1)
+		if err != nil {
+			return nil, err
+		}
+		r, _, err := appendCardRank(ctx, tx, *target)
+		if err != nil {
+			return nil, err
+		}
+		rank = &r
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE issues
+		SET project_id = $2,
+			column_id = $3,
+			rank = $5,
+			assigned_to = CASE WHEN $4 THEN NULL ELSE assigned_to END,
+			parent_issue_id = NULL,
+			sprint_id = NULL,
+			backlog_order = NULL,
+			fix_version_id = NULL,
+			moved_from_project_id = project_id,
+			version = version + 1,
+			updated_at = NOW()
+		WHERE id = $1
+	`, issueID, targetProjectID, target, clearAssignee, rank)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hierarchies never span projects: children left behind lose their parent.
+	_, err = tx.Exec(ctx, `
+		UPDATE issues SET parent_issue_id = NULL, version = version + 1, updated_at = NOW()
+		WHERE parent_issue_id = $1
+	`, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	mapped, dropped, err := remapLabels(ctx, tx, issueID, issueID, targetProjectID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &models.MoveIssueResult{
+		FromProjectID: fromProjectID,
+		FromColumnID:  oldColumnID,
+		ColumnID:      target,
+		Unassigned:    clearAssignee,
+		LabelsMapped:  mapped,
+		LabelsDropped: dropped,
+		WIPWarning:    warning,
+	}, nil
+}
+
+// Clone inserts clone as a copy of the source issue, places it on the board
+// of clone.ProjectID, copies labels plus whatever req opts into, and links
+// the copy back to its source with a cloned_from relation. It returns the
+// soft WIP limit warning of the column the copy lands in, if any.
+func (r *IssueRepoPG) Clone(ctx context.Context, sourceID string, clone *models.Issue, req models.CloneIssueRequest) (*models.WIPWarning, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var (
+		srcProjectID string
+		srcColumnID  *string
+	)
+	err = tx.QueryRow(ctx, `
+		SELECT project_id, column_id FROM issues WHERE id = $1 AND deleted_at IS NULL
+	`, sourceID).Scan(&srcProjectID, &srcColumnID)
+	if err == pgx.ErrNoRows {
+		return nil, errors.New("issue not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var column *string
+	if srcProjectID == clone.ProjectID {
+		column = srcColumnID
+	} else if column, err = targetColumn(ctx, tx, clone.ProjectID, nil, srcColumnID); err != nil {
+		return nil, err
+	}
+
+	warning, err := insertIssueTx(ctx, tx, clone, column)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := remapLabels(ctx, tx, sourceID, clone.ID, clone.ProjectID, false); err != nil {
+		return nil, err
+	}
+
+	if req.Subtasks {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO subtasks (id, parent_issue_id, title, description, status, assigned_to, due_date, order_index, created_at)
+			SELECT gen_random_uuid(), $2, title, description, status, assigned_to, due_date, order_index, NOW()
+			FROM subtasks WHERE parent_issue_id = $1
+		`, sourceID, clone.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Checklists {
+		if err := cloneChecklists(ctx, tx, sourceID, clone.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Attachments {
+		// The copies point at the same stored objects.
+		_, err = tx.Exec(ctx, `
+			INSERT INTO issue_attachments (id, issue_id, user_id, url, key, filename, content_type, size, created_at)
+			SELECT gen_random_uuid(), $2, user_id, url, key, filename, content_type, size, NOW()
+			FROM issue_attachments WHERE issue_id = $1
+		`, sourceID, clone.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Relations {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO issue_relations (id, issue_id, related_issue_id, relation_type, created_at)
+			SELECT gen_random_uuid(), $2, related_issue_id, relation_type, NOW()
+			FROM issue_relations
+			WHERE issue_id = $1 AND relation_type <> $3
+		`, sourceID, clone.ID, models.RelationClonedFrom)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO issue_relations (id, issue_id, related_issue_id, relation_type, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+	`, uuid.NewString(), clone.ID, sourceID, models.RelationClonedFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return warning, nil
+}
+
+// targetColumn picks the column an issue lands in on another project's
+// board: the requested one, else the column named like its current one,
+// else the first column. Columns of the default board come first and
+// archived columns are skipped. It returns nil when the target has no board.
+func targetColumn(ctx context.Context, tx pgx.Tx, projectID string, requested, current *string) (*string, error) {
+	var id string
+
+	if requested != nil {
+		err := tx.QueryRow(ctx, `
+			SELECT id FROM kanban_columns WHERE id = $1 AND project_id = $2 AND archived_at IS NULL
+		`, *requested, projectID).Scan(&id)
+		if err == pgx.ErrNoRows {
+			return nil, errors.New("column does not belong to the target project or is archived")
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &id, nil
+	}
+
+	if current != nil {
+		err := tx.QueryRow(ctx, `
+			SELECT t.id
+			FROM kanban_columns c
+			JOIN kanban_columns t ON t.project_id = $2 AND lower(t.name) = lower(c.name)
+			JOIN boards b ON b.id = t.board_id
+			WHERE c.id = $1 AND t.archived_at IS NULL
+			ORDER BY b.is_default DESC, b.created_at, t.rank ASC, t.id
+			LIMIT 1
+		`, *current, projectID).Scan(&id)
+		if err == nil {
+			return &id, nil
+		}
+		if err != pgx.ErrNoRows {
+			return nil, err
+		}
+	}
+
+	err := tx.QueryRow(ctx, `
+		SELECT c.id FROM kanban_columns c
+		JOIN boards b ON b.id = c.board_id
+		WHERE c.project_id = $1 AND c.archived_at IS NULL
+		ORDER BY b.is_default DESC, b.created_at, c.rank ASC, c.id
+		LIMIT 1
+	`, projectID).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// remapLabels gives toIssue the labels of fromIssue, translated by name into
+// projectID's labels. Labels without an equivalent are dropped. With replace
+// set the existing labels of toIssue are removed first (moves).
+func remapLabels(ctx context.Context, tx pgx.Tx, fromIssue, toIssue, projectID string, replace bool) (map[string]string, []string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT ol.id::text, nl.id::text
+		FROM issue_labels il
+		JOIN labels ol ON ol.id = il.label_id
+		LEFT JOIN LATERAL (
+			SELECT id FROM labels
+			WHERE project_id = $2 AND lower(name) = lower(ol.name)
+			ORDER BY created_at ASC
+			LIMIT 1
+		) nl ON true
+		WHERE il.issue_id = $1
+	`, fromIssue, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mapped := map[string]string{}
+	dropped := []string{}
+	for rows.Next() {
+		var oldID string
+		var newID *string
+		if err := rows.Scan(&oldID, &newID); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		if newID == nil {
+			dropped = append(dropped, oldID)
+			continue
+		}
+		mapped[oldID] = *newID
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if replace {
+		if _, err := tx.Exec(ctx, `DELETE FROM issue_labels WHERE issue_id = $1`, toIssue); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, newID := range mapped {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO issue_labels (issue_id, label_id) VALUES ($1, $2)
+			ON CONFLICT DO NOTHING
+		`, toIssue, newID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return mapped, dropped, nil
+}
+
+// cloneChecklists copies every checklist of src onto dst with its items
+// unticked.
+func cloneChecklists(ctx context.Context, tx pgx.Tx, src, dst string) error {
+	rows, err := tx.Query(ctx, `
+		SELECT id::text, title FROM checklists WHERE issue_id = $1 ORDER BY created_at ASC
+	`, src)
+	if err != nil {
+		return err
+	}
+
+	type checklist struct{ id, title string }
+	var lists []checklist
+	for rows.Next() {
+		var cl checklist
+		if err := rows.Scan(&cl.id, &cl.title); err != nil {
+			rows.Close()
+			return err
+		}
+		lists = append(lists, cl)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, cl := range lists {
+		newID := uuid.NewString()
+		_, err := tx.Exec(ctx, `
+			INSERT INTO checklists (id, issue_id, title, created_at) VALUES ($1, $2, $3, NOW())
+		`, newID, dst, cl.title)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO checklist_items (id, checklist_id, content, done, order_index)
+			SELECT gen_random_uuid(), $2, content, FALSE, order_index
+			FROM checklist_items WHERE checklist_id = $1
+		`, cl.id, newID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
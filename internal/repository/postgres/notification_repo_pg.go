@@ -43,23 +43,25 @@ func (r *NotificationRepoPG) Create(notification *models.Notification) error {
 	return nil
 }
 
-func (r *NotificationRepoPG) GetByUser(userID string) ([]models.Notification, error) {
+func (r *NotificationRepoPG) GetByUser(userID string, p models.PageRequest) ([]models.Notification, models.PageInfo, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	where, tail, args := keyset(p, "created_at", "id", true, 2)
+
 	query := `
 	SELECT id, user_id, type, title, message, metadata, is_read, created_at
 	FROM notifications
 	WHERE user_id = $1
-	ORDER BY created_at DESC
-	`
-	rows, err := r.pool.Query(ctx, query, userID)
+	` + where + tail
+
+	rows, err := r.pool.Query(ctx, query, append([]interface{}{userID}, args...)...)
 	if err != nil {
-		return nil, err
+		return nil, models.PageInfo{}, err
 	}
 	defer rows.Close()
 
-	var out []models.Notification
+	out := []models.Notification{}
 	for rows.Next() {
 		var n models.Notification
 		err := rows.Scan(
@@ -73,11 +75,27 @@ func (r *NotificationRepoPG) GetByUser(userID string) ([]models.Notification, er
 			&n.CreatedAt,
 		)
 		if err != nil {
-			return nil, err
+			return nil, models.PageInfo{}, err
 		}
 		out = append(out, n)
 	}
-	return out, nil
+
+	out, info := finishPage(out, p, func(n models.Notification) models.Cursor {
+		return models.Cursor{CreatedAt: n.CreatedAt, ID: n.ID}
+	})
+
+	if p.WithTotal {
+		var total int
+		err := r.pool.QueryRow(ctx,
+			`SELECT COUNT(*) FROM notifications WHERE user_id = $1`, userID,
+		).Scan(&total)
+		if err != nil {
+			return nil, models.PageInfo{}, err
+		}
+		info.Total = &total
+	}
+
+	return out, info, nil
 }
 
 func (r *NotificationRepoPG) MarkRead(id string) error {
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	"fmt"
+)
+
+// keyset returns the WHERE fragment, ORDER BY clause and LIMIT for one page of
+// a list ordered by (tsCol, idCol). desc is the natural order of the list;
+// backward cursors walk it in reverse and finishPage flips the rows back.
+//
+// One extra row is requested so finishPage can tell whether more rows exist.
+func keyset(p models.PageRequest, tsCol, idCol string, desc bool, idx int) (string, string, []interface{}) {
+	backward := p.Cursor != nil && p.Cursor.Backward
+	descending := desc != backward
+
+	dir, cmp := "ASC", ">"
+	if descending {
+		dir, cmp = "DESC", "<"
+	}
+
+	where := ""
+	var args []interface{}
+	if p.Cursor != nil {
+		where = fmt.Sprintf(" AND (%s, %s) %s ($%d, $%d)", tsCol, idCol, cmp, idx, idx+1)
+		args = append(args, p.Cursor.CreatedAt, p.Cursor.ID)
+		idx += 2
+	}
+
+	tail := fmt.Sprintf(" ORDER BY %s %s, %s %s LIMIT $%d", tsCol, dir, idCol, dir, idx)
+	args = append(args, p.Limit+1)
+
+	return where, tail, args
+}
+
+// finishPage trims the look-ahead row, restores natural order for backward
+// pages and builds the cursors pointing at the neighbouring pages.
+func finishPage[T any](items []T, p models.PageRequest, key func(T) models.Cursor) ([]T, models.PageInfo) {
+	backward := p.Cursor != nil && p.Cursor.Backward
+
+	hasMore := len(items) > p.Limit
+	if hasMore {
+		items = items[:p.Limit]
+	}
+
+	if backward {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	info := models.PageInfo{Limit: p.Limit}
+	if len(items) == 0 {
+		return items, info
+	}
+
+	hasNext := (!backward && hasMore) || backward
+	hasPrev := (backward && hasMore) || (!backward && p.Cursor != nil)
+
+	if hasNext {
+		c := key(items[len(items)-1])
+		s := models.EncodeCursor(c)
+		info.NextCursor = &s
+	}
+	if hasPrev {
+		c := key(items[0])
+		c.Backward = true
+		s := models.EncodeCursor(c)
+		info.PrevCursor = &s
+	}
+
+	return items, info
+}
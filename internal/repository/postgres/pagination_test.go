@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type pageRow struct {
+	id string
+	at time.Time
+}
+
+func pageKey(r pageRow) models.Cursor {
+	return models.Cursor{CreatedAt: r.at, ID: r.id}
+}
+
+func pageRows(ids ...string) []pageRow {
+	out := make([]pageRow, len(ids))
+	for i, id := range ids {
+		out[i] = pageRow{id: id, at: time.Unix(int64(i), 0).UTC()}
+	}
+	return out
+}
+
+func pageIDs(rows []pageRow) []string {
+	out := []string{}
+	for _, r := range rows {
+		out = append(out, r.id)
+	}
+	return out
+}
+
+func TestKeyset(t *testing.T) {
+	at := time.Unix(100, 0).UTC()
+
+	tests := []struct {
+		name      string
+		p         models.PageRequest
+		desc      bool
+		wantWhere string
+		wantTail  string
+		wantArgs  []interface{}
+	}{
+		{
+			name:     "first page",
+			p:        models.PageRequest{Limit: 20},
+			desc:     true,
+			wantTail: " ORDER BY a.created_at DESC, a.id DESC LIMIT $2",
+			wantArgs: []interface{}{21},
+		},
+		{
+			name:      "forward cursor",
+			p:         models.PageRequest{Limit: 5, Cursor: &models.Cursor{CreatedAt: at, ID: "x"}},
+			desc:      true,
+			wantWhere: " AND (a.created_at, a.id) < ($2, $3)",
+			wantTail:  " ORDER BY a.created_at DESC, a.id DESC LIMIT $4",
+			wantArgs:  []interface{}{at, "x", 6},
+		},
+		{
+			name:      "backward cursor walks the list in reverse",
+			p:         models.PageRequest{Limit: 5, Cursor: &models.Cursor{CreatedAt: at, ID: "x", Backward: true}},
+			desc:      true,
+			wantWhere: " AND (a.created_at, a.id) > ($2, $3)",
+			wantTail:  " ORDER BY a.created_at ASC, a.id ASC LIMIT $4",
+			wantArgs:  []interface{}{at, "x", 6},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			where, tail, args := keyset(tt.p, "a.created_at", "a.id", tt.desc, 2)
+			if where != tt.wantWhere {
+				t.Errorf("where = %q, want %q", where, tt.wantWhere)
+			}
+			if tail != tt.wantTail {
+				t.Errorf("tail = %q, want %q", tail, tt.wantTail)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestFinishPage(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     []pageRow
+		cursor   *models.Cursor
+		wantIDs  []string
+		wantNext string // id the next cursor points at, "" for none
+		wantPrev string
+	}{
+		{name: "single page", rows: pageRows("a", "b"), wantIDs: []string{"a", "b"}},
+		{name: "more after the first page", rows: pageRows("a", "b", "c"), wantIDs: []string{"a", "b"}, wantNext: "b"},
+		{
+			name:     "middle page",
+			rows:     pageRows("c", "d", "e"),
+			cursor:   &models.Cursor{ID: "b"},
+			wantIDs:  []string{"c", "d"},
+			wantNext: "d",
+			wantPrev: "c",
+		},
+		{
+			name:     "backward page is flipped back",
+			rows:     pageRows("b", "a"),
+			cursor:   &models.Cursor{ID: "c", Backward: true},
+			wantIDs:  []string{"a", "b"},
+			wantNext: "b",
+		},
+		{name: "empty", rows: nil, cursor: &models.Cursor{ID: "z"}, wantIDs: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, info := finishPage(tt.rows, models.PageRequest{Limit: 2, Cursor: tt.cursor}, pageKey)
+
+			if ids := pageIDs(got); !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("rows = %v, want %v", ids, tt.wantIDs)
+			}
+			checkPageCursor(t, "next", info.NextCursor, tt.wantNext, false)
+			checkPageCursor(t, "prev", info.PrevCursor, tt.wantPrev, true)
+		})
+	}
+}
+
+func checkPageCursor(t *testing.T, name string, got *string, wantID string, wantBackward bool) {
+	t.Helper()
+	if got == nil {
+		if wantID != "" {
+			t.Errorf("%s cursor missing, want one at %q", name, wantID)
+		}
+		return
+	}
+	if wantID == "" {
+		t.Errorf("unexpected %s cursor %q", name, *got)
+		return
+	}
+	c, err := models.DecodeCursor(*got)
+	if err != nil {
+		t.Fatalf("%s cursor: %v", name, err)
+	}
+	if c.ID != wantID || c.Backward != wantBackward {
+		t.Errorf("%s cursor = %+v, want id %q backward %v", name, *c, wantID, wantBackward)
+	}
+}
@@ -0,0 +1,134 @@
+// Package pgtest sets up throwaway projects for the repository tests.
+//
+// The tests run against a migrated database named by
+// BUGFORGE_TEST_DATABASE_URL that holds at least one customer with a user;
+// they are skipped when it is not set. Every test works in its own project,
+// which is removed again when the test ends.
+package pgtest
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Project is a test project with a default board.
+type Project struct {
+	T       *testing.T
+	DB      *pgxpool.Pool
+	ID      string
+	BoardID string
+	UserID  string
+
+	lastRank string
+}
+
+// NewProject creates the project, or skips the test without a database.
+func NewProject(t *testing.T) *Project {
+	t.Helper()
+
+	url := os.Getenv("BUGFORGE_TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("BUGFORGE_TEST_DATABASE_URL not set")
+	}
+	ctx := context.Background()
+	db, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Close)
+
+	p := &Project{T: t, DB: db, ID: uuid.NewString(), BoardID: uuid.NewString()}
+
+	var customerID string
+	err = db.QueryRow(ctx, `SELECT customer_id, id FROM users LIMIT 1`).Scan(&customerID, &p.UserID)
+	if err == pgx.ErrNoRows {
+		t.Skip("test database has no users")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO projects (id, customer_id, name, slug, created_at, updated_at)
+		VALUES ($1, $2, $3, $3, NOW(), NOW())
+	`, p.ID, customerID, "test-"+p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		for _, q := range []string{
+			`DELETE FROM issues WHERE project_id = $1`,
+			`DELETE FROM kanban_columns WHERE project_id = $1`,
+			`DELETE FROM boards WHERE project_id = $1`,
+			`DELETE FROM projects WHERE id = $1`,
+		} {
+			if _, err := db.Exec(ctx, q, p.ID); err != nil {
+				t.Errorf("cleanup: %v", err)
+			}
+		}
+	})
+
+	_, err = db.Exec(ctx, `
+		INSERT INTO boards (id, project_id, name, is_default, created_by)
+		VALUES ($1, $2, 'Board', TRUE, $3)
+	`, p.BoardID, p.ID, p.UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// Exec runs a statement that sets up the test, failing it on error.
+func (p *Project) Exec(sql string, args ...any) {
+	p.T.Helper()
+	if _, err := p.DB.Exec(context.Background(), sql, args...); err != nil {
+		p.T.Fatal(err)
+	}
+}
+
+// Column appends a column without a WIP limit to the board.
+func (p *Project) Column(name string) string {
+	p.T.Helper()
+	p.lastRank = models.RankAfter(p.lastRank)
+	id := uuid.NewString()
+	p.Exec(`
+		INSERT INTO kanban_columns (id, project_id, board_id, name, rank)
+		VALUES ($1, $2, $3, $4, $5)
+	`, id, p.ID, p.BoardID, name, p.lastRank)
+	return id
+}
+
+// Issue inserts an issue; columnID and rank may be empty for issues off
+// the board.
+func (p *Project) Issue(issueType, columnID, rank string, parentID *string) string {
+	p.T.Helper()
+	id := uuid.NewString()
+	p.Exec(`
+		INSERT INTO issues (id, project_id, column_id, rank, title, description, status, priority,
+			issue_type, parent_issue_id, created_by, custom_fields, created_at, updated_at)
+		VALUES ($1, $2, NULLIF($3, '')::uuid, NULLIF($4, ''), $5, '', 'open', 'medium',
+			$6, $7, $8, '{}', NOW(), NOW())
+	`, id, p.ID, columnID, rank, issueType+"-"+id[:8], issueType, parentID, p.UserID)
+	return id
+}
+
+// ColumnCards lists the column's cards, trashed ones included, by rank.
+func (p *Project) ColumnCards(columnID string) []string {
+	p.T.Helper()
+	rows, err := p.DB.Query(context.Background(),
+		`SELECT id FROM issues WHERE column_id = $1 ORDER BY rank, id`, columnID)
+	if err != nil {
+		p.T.Fatal(err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		p.T.Fatal(err)
+	}
+	return ids
+}
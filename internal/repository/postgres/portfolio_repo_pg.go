@@ -0,0 +1,285 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PortfolioRepoPG struct {
+	db *pgxpool.Pool
+}
+
+func NewPortfolioRepository(db *pgxpool.Pool) repo.PortfolioRepository {
+	return &PortfolioRepoPG{db: db}
+}
+
+func (r *PortfolioRepoPG) CreatePortfolio(ctx context.Context, p *models.Portfolio) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO portfolios (id, customer_id, name, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`, p.ID, p.CustomerID, p.Name, p.CreatedBy).Scan(&p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	if err := writePortfolioDefinition(ctx, tx, p); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *PortfolioRepoPG) UpdatePortfolio(ctx context.Context, p *models.Portfolio) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, `
+		UPDATE portfolios SET name = $2, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`, p.ID, p.Name).Scan(&p.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	// The mappings go with the columns
+	if _, err := tx.Exec(ctx, `DELETE FROM portfolio_columns WHERE portfolio_id = $1`, p.ID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM portfolio_projects WHERE portfolio_id = $1`, p.ID); err != nil {
+		return err
+	}
+	if err := writePortfolioDefinition(ctx, tx, p); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// writePortfolioDefinition inserts the projects, columns and mappings of a
+// portfolio, ranking the columns in slice order.
+func writePortfolioDefinition(ctx context.Context, tx pgx.Tx, p *models.Portfolio) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO portfolio_projects (portfolio_id, project_id)
+		SELECT $1, unnest($2::uuid[])
+	`, p.ID, p.ProjectIDs)
+	if err != nil {
+		return err
+	}
+
+	ranks := models.RankSequence(len(p.Columns))
+	for n := range p.Columns {
+		col := &p.Columns[n]
+		col.Order, col.Rank = n+1, ranks[n]
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO portfolio_columns (id, portfolio_id, name, rank)
+			VALUES ($1, $2, $3, $4)
+		`, col.ID, p.ID, col.Name, col.Rank)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO portfolio_column_mappings (portfolio_id, portfolio_column_id, column_id)
+			SELECT $1, $2, unnest($3::uuid[])
+		`, p.ID, col.ID, col.ColumnIDs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *PortfolioRepoPG) DeletePortfolio(ctx context.Context, portfolioID string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM portfolios WHERE id = $1`, portfolioID)
+	return err
+}
+
+func (r *PortfolioRepoPG) GetPortfolioByID(ctx context.Context, portfolioID string) (*models.Portfolio, error) {
+	var p models.Portfolio
+	err := r.db.QueryRow(ctx, `
+		SELECT id, customer_id, name, created_by, created_at, updated_at
+		FROM portfolios WHERE id = $1
+	`, portfolioID).Scan(&p.ID, &p.CustomerID, &p.Name, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadDefinitions(ctx, []*models.Portfolio{&p}); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *PortfolioRepoPG) ListPortfolios(ctx context.Context, customerID string) ([]models.Portfolio, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, customer_id, name, created_by, created_at, updated_at
+		FROM portfolios WHERE customer_id = $1
+		ORDER BY name ASC
+	`, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []models.Portfolio{}
+	for rows.Next() {
+		var p models.Portfolio
+		if err := rows.Scan(&p.ID, &p.CustomerID, &p.Name, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ptrs := make([]*models.Portfolio, len(out))
+	for n := range out {
+		ptrs[n] = &out[n]
+	}
+	return out, r.loadDefinitions(ctx, ptrs)
+}
+
+// loadDefinitions fills the projects and the mapped columns of portfolios.
+func (r *PortfolioRepoPG) loadDefinitions(ctx context.Context, ps []*models.Portfolio) error {
+	if len(ps) == 0 {
+		return nil
+	}
+	byID := map[string]*models.Portfolio{}
+	ids := make([]string, len(ps))
+	for n, p := range ps {
+		p.ProjectIDs, p.Columns = []string{}, []models.PortfolioColumn{}
+		byID[p.ID] = p
+		ids[n] = p.ID
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT pp.portfolio_id, pp.project_id
+		FROM portfolio_projects pp
+		JOIN projects p ON p.id = pp.project_id
+		WHERE pp.portfolio_id = ANY($1)
+		ORDER BY p.name, p.id
+	`, ids)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var portfolioID, projectID string
+		if err := rows.Scan(&portfolioID, &projectID); err != nil {
+			rows.Close()
+			return err
+		}
+		byID[portfolioID].ProjectIDs = append(byID[portfolioID].ProjectIDs, projectID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	rows, err = r.db.Query(ctx, `
+		SELECT pc.portfolio_id, pc.id, pc.name, pc.rank,
+			ROW_NUMBER() OVER (PARTITION BY pc.portfolio_id ORDER BY pc.rank, pc.id),
+			COALESCE(array_agg(m.column_id) FILTER (WHERE m.column_id IS NOT NULL), '{}')
+		FROM portfolio_columns pc
+		LEFT JOIN portfolio_column_mappings m ON m.portfolio_column_id = pc.id
+		WHERE pc.portfolio_id = ANY($1)
+		GROUP BY pc.portfolio_id, pc.id
+		ORDER BY pc.portfolio_id, pc.rank, pc.id
+	`, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var portfolioID string
+		var col models.PortfolioColumn
+		if err := rows.Scan(&portfolioID, &col.ID, &col.Name, &col.Rank, &col.Order, &col.ColumnIDs); err != nil {
+			return err
+		}
+		byID[portfolioID].Columns = append(byID[portfolioID].Columns, col)
+	}
+	return rows.Err()
+}
+
+func (r *PortfolioRepoPG) ListProjectColumns(ctx context.Context, projectIDs []string) ([]models.KanbanColumn, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, project_id, board_id, name, rank, wip_limit, wip_mode, archived_at
+		FROM kanban_columns
+		WHERE project_id = ANY($1)
+		ORDER BY project_id, board_id, rank, id
+	`, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []models.KanbanColumn{}
+	for rows.Next() {
+		var c models.KanbanColumn
+		if err := rows.Scan(&c.ID, &c.ProjectID, &c.BoardID, &c.Name, &c.Rank, &c.WIPLimit, &c.WIPMode, &c.ArchivedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+func (r *PortfolioRepoPG) ListPortfolioCards(ctx context.Context, portfolioID string, projectIDs []string) (map[string][]models.PortfolioCard, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT m.portfolio_column_id,
+			i.id, i.project_id, i.column_id, i.pos, COALESCE(i.rank, ''), i.title, i.description,
+			i.status, i.priority, i.issue_type, i.assigned_to, i.due_date,
+			i.created_by, i.created_at, i.updated_at,
+			p.slug, p.name, c.name
+		FROM portfolio_column_mappings m
+		JOIN kanban_columns c ON c.id = m.column_id AND c.archived_at IS NULL
+		JOIN projects p ON p.id = c.project_id AND p.deleted_at IS NULL
+		JOIN (
+			SELECT *, ROW_NUMBER() OVER (PARTITION BY column_id ORDER BY rank, id) AS pos
+			FROM issues
+			WHERE deleted_at IS NULL AND project_id = ANY($2)
+		) i ON i.column_id = c.id
+		WHERE m.portfolio_id = $1 AND c.project_id = ANY($2)
+		ORDER BY p.name, p.id, c.rank, c.id, i.pos
+	`, portfolioID, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := map[string][]models.PortfolioCard{}
+	for rows.Next() {
+		var columnID string
+		var card models.PortfolioCard
+		if err := rows.Scan(
+			&columnID,
+			&card.ID, &card.ProjectID, &card.ColumnID, &card.Order, &card.Rank, &card.Title, &card.Description,
+			&card.Status, &card.Priority, &card.IssueType, &card.AssignedTo, &card.DueDate,
+			&card.CreatedBy, &card.CreatedAt, &card.UpdatedAt,
+			&card.ProjectKey, &card.ProjectName, &card.ColumnName,
+		); err != nil {
+			return nil, err
+		}
+		out[columnID] = append(out[columnID], card)
+	}
+	return out, rows.Err()
+}
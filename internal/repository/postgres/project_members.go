@@ -34,16 +34,18 @@ func (r *ProjectMemberRepoPG) RemoveMember(ctx context.Context, projectID, userI
     return err
 }
 
-func (r *ProjectMemberRepoPG) ListMembers(ctx context.Context, projectID, customerID string) ([]models.User, error) {
+func (r *ProjectMemberRepoPG) ListMembers(ctx context.Context, projectID, customerID string, p models.PageRequest) ([]models.User, models.PageInfo, error) {
+    where, tail, args := keyset(p, "u.created_at", "u.id", false, 3)
+
     rows, err := r.db.Query(ctx,
         `SELECT u.id, u.name, u.email, u.created_at, u.updated_at
          FROM project_members pm
          JOIN users u ON pm.user_id = u.id
-         WHERE pm.project_id = $1 AND u.customer_id = $2`,
-        projectID, customerID,
+         WHERE pm.project_id = $1 AND u.customer_id = $2`+where+tail,
+        append([]interface{}{projectID, customerID}, args...)...,
     )
     if err != nil {
-        return nil, err
+        return nil, models.PageInfo{}, err
     }
     defer rows.Close()
 
@@ -51,12 +53,31 @@ func (r *ProjectMemberRepoPG) ListMembers(ctx context.Context, projectID, custom
     for rows.Next() {
         var u models.User
         if err := rows.Scan(&u.ID, &u.Name, &u.Email, &u.CreatedAt, &u.UpdatedAt); err != nil {
-            return nil, err
+            return nil, models.PageInfo{}, err
         }
         members = append(members, u)
     }
 
-    return members, nil
+    members, info := finishPage(members, p, func(u models.User) models.Cursor {
+        return models.Cursor{CreatedAt: u.CreatedAt, ID: u.ID}
+    })
+
+    if p.WithTotal {
+        var total int
+        err := r.db.QueryRow(ctx,
+            `SELECT COUNT(*)
+             FROM project_members pm
+             JOIN users u ON pm.user_id = u.id
+             WHERE pm.project_id = $1 AND u.customer_id = $2`,
+            projectID, customerID,
+        ).Scan(&total)
+        if err != nil {
+            return nil, models.PageInfo{}, err
+        }
+        info.Total = &total
+    }
+
+    return members, info, nil
 }
 
 func (r *ProjectMemberRepoPG) IsMember(ctx context.Context, projectID, userID string) (bool, error) {
@@ -4,6 +4,7 @@ import (
 	"bugforge-backend/internal/models"
 	repo "bugforge-backend/internal/repository/interfaces"
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -30,7 +31,7 @@ func (r *ProjectRepositoryImpl) GetAll(ctx context.Context, customerID string) (
 	query := `
         SELECT id, customer_id, name, slug, created_at, updated_at
         FROM projects
-        WHERE customer_id = $1
+        WHERE customer_id = $1 AND deleted_at IS NULL
         ORDER BY created_at DESC
     `
 
@@ -64,7 +65,7 @@ func (r *ProjectRepositoryImpl) GetByID(ctx context.Context, id string, customer
 	query := `
         SELECT id, customer_id, name, slug, created_at, updated_at
         FROM projects
-        WHERE id = $1 AND customer_id = $2
+        WHERE id = $1 AND customer_id = $2 AND deleted_at IS NULL
         LIMIT 1
     `
 
@@ -89,7 +90,7 @@ func (r *ProjectRepositoryImpl) GetBySlug(ctx context.Context, slug string, cust
 	query := `
         SELECT id, customer_id, name, slug, created_at, updated_at
         FROM projects
-        WHERE slug = $1 AND customer_id = $2
+        WHERE slug = $1 AND customer_id = $2 AND deleted_at IS NULL
         LIMIT 1
     `
 
@@ -114,15 +115,176 @@ func (r *ProjectRepositoryImpl) Update(ctx context.Context, p *models.Project) e
 	query := `
         UPDATE projects
         SET name = $1, slug = $2, updated_at = NOW()
-        WHERE id = $3 AND customer_id = $4
+        WHERE id = $3 AND customer_id = $4 AND deleted_at IS NULL
     `
 	_, err := r.db.Exec(ctx, query, p.Name, p.Slug, p.ID, p.CustomerID)
 	return err
 }
 
 
-func (r *ProjectRepositoryImpl) Delete(ctx context.Context, id string, customerID string) error {
-	query := `DELETE FROM projects WHERE id = $1 AND customer_id = $2`
-	_, err := r.db.Exec(ctx, query, id, customerID)
-	return err
+func (r *ProjectRepositoryImpl) Delete(ctx context.Context, id string, customerID string, deletedBy string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var deletedAt time.Time
+	err = tx.QueryRow(ctx, `
+        UPDATE projects
+        SET deleted_at = NOW(), deleted_by = $3
+        WHERE id = $1 AND customer_id = $2 AND deleted_at IS NULL
+        RETURNING deleted_at
+    `, id, customerID, deletedBy).Scan(&deletedAt)
+	if err == pgx.ErrNoRows {
+		return models.ErrProjectNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	// Children are stamped with the project's deleted_at so Restore brings
+	// back exactly what went to the trash with it.
+	_, err = tx.Exec(ctx, `
+        UPDATE issue_comments c
+        SET deleted_at = $2, deleted_by = $3
+        FROM issues i
+        WHERE i.id = c.issue_id AND i.project_id = $1
+          AND i.deleted_at IS NULL AND c.deleted_at IS NULL
+    `, id, deletedAt, deletedBy)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE issues
+        SET deleted_at = $2, deleted_by = $3, version = version + 1
+        WHERE project_id = $1 AND deleted_at IS NULL
+    `, id, deletedAt, deletedBy)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *ProjectRepositoryImpl) GetDeletedByID(ctx context.Context, id string, customerID string) (*models.Project, error) {
+	query := `
+        SELECT id, customer_id, name, slug, created_at, updated_at, deleted_at, deleted_by
+        FROM projects
+        WHERE id = $1 AND customer_id = $2 AND deleted_at IS NOT NULL
+        LIMIT 1
+    `
+
+	var p models.Project
+	err := r.db.QueryRow(ctx, query, id, customerID).Scan(
+		&p.ID,
+		&p.CustomerID,
+		&p.Name,
+		&p.Slug,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+		&p.DeletedAt,
+		&p.DeletedBy,
+	)
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return &p, err
+}
+
+func (r *ProjectRepositoryImpl) ListDeleted(ctx context.Context, customerID string) ([]models.Project, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT id, customer_id, name, slug, created_at, updated_at, deleted_at, deleted_by
+        FROM projects
+        WHERE customer_id = $1 AND deleted_at IS NOT NULL
+        ORDER BY deleted_at DESC
+    `, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	projects := []models.Project{}
+
+	for rows.Next() {
+		var p models.Project
+		if err := rows.Scan(
+			&p.ID,
+			&p.CustomerID,
+			&p.Name,
+			&p.Slug,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+			&p.DeletedAt,
+			&p.DeletedBy,
+		); err != nil {
+			return nil, err
+		}
+		projects = append(projects, p)
+	}
+
+	return projects, nil
+}
+
+func (r *ProjectRepositoryImpl) Restore(ctx context.Context, id string, customerID string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var deletedAt time.Time
+	err = tx.QueryRow(ctx, `
+        SELECT deleted_at FROM projects
+        WHERE id = $1 AND customer_id = $2 AND deleted_at IS NOT NULL
+        FOR UPDATE
+    `, id, customerID).Scan(&deletedAt)
+	if err == pgx.ErrNoRows {
+		return models.ErrNotInTrash
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE projects SET deleted_at = NULL, deleted_by = NULL WHERE id = $1
+    `, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE issue_comments c
+        SET deleted_at = NULL, deleted_by = NULL
+        FROM issues i
+        WHERE i.id = c.issue_id AND i.project_id = $1
+          AND i.deleted_at = $2 AND c.deleted_at = $2
+    `, id, deletedAt)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+        UPDATE issues SET deleted_at = NULL, deleted_by = NULL, version = version + 1
+        WHERE project_id = $1 AND deleted_at = $2
+    `, id, deletedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *ProjectRepositoryImpl) PurgeDeleted(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM projects WHERE deleted_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
 }
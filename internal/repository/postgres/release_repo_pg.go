@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReleaseRepoPG struct {
+	db *pgxpool.Pool
+}
+
+func NewReleaseRepository(db *pgxpool.Pool) repo.ReleaseRepository {
+	return &ReleaseRepoPG{db: db}
+}
+
+// releaseSelect loads releases with their progress; append a WHERE clause.
+const releaseSelect = `
+	SELECT r.id, r.customer_id, r.project_id, r.name, r.description, r.target_date,
+		r.released, r.released_at, r.created_by, r.created_at, r.updated_at,
+		p.total, p.done, p.points, p.points_done
+	FROM releases r
+	LEFT JOIN LATERAL (
+		SELECT COUNT(*) AS total,
+			COUNT(*) FILTER (WHERE i.status = ANY($1)) AS done,
+			COALESCE(SUM(i.story_points), 0) AS points,
+			COALESCE(SUM(i.story_points) FILTER (WHERE i.status = ANY($1)), 0) AS points_done
+		FROM issues i
+		WHERE i.fix_version_id = r.id AND i.deleted_at IS NULL
+	) p ON true
+`
+
+func scanRelease(row pgx.Row) (*models.Release, error) {
+	var r models.Release
+	var total, done, points, pointsDone int
+	err := row.Scan(
+		&r.ID, &r.CustomerID, &r.ProjectID, &r.Name, &r.Description, &r.TargetDate,
+		&r.Released, &r.ReleasedAt, &r.CreatedBy, &r.CreatedAt, &r.UpdatedAt,
+		&total, &done, &points, &pointsDone,
+	)
+	if err != nil {
+		return nil, err
+	}
+	r.Progress = models.NewReleaseProgress(total, done, points, pointsDone)
+	return &r, nil
+}
+
+func (r *ReleaseRepoPG) CreateRelease(ctx context.Context, rel *models.Release) error {
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO releases (id, customer_id, project_id, name, description, target_date, released, released_at,
+			created_by, created_at, updated_at)
+		VALUES ($1,$2,$3,$4,$5,$6,$7, CASE WHEN $7 THEN NOW() END, $8, NOW(), NOW())
+		RETURNING released_at, created_at, updated_at
+	`,
+		rel.ID, rel.CustomerID, rel.ProjectID, rel.Name, rel.Description, rel.TargetDate, rel.Released, rel.CreatedBy,
+	).Scan(&rel.ReleasedAt, &rel.CreatedAt, &rel.UpdatedAt)
+	if err != nil {
+		return err
+	}
+	rel.Progress = models.NewReleaseProgress(0, 0, 0, 0)
+	return nil
+}
+
+func (r *ReleaseRepoPG) UpdateRelease(ctx context.Context, rel *models.Release) error {
+	return r.db.QueryRow(ctx, `
+		UPDATE releases
+		SET name=$2, description=$3, target_date=$4, released=$5,
+			released_at = CASE
+				WHEN NOT $5 THEN NULL
+				WHEN released THEN released_at
+				ELSE NOW()
+			END,
+			updated_at=NOW()
+		WHERE id=$1
+		RETURNING released_at, updated_at
+	`, rel.ID, rel.Name, rel.Description, rel.TargetDate, rel.Released).Scan(&rel.ReleasedAt, &rel.UpdatedAt)
+}
+
+// DeleteRelease removes the release; its issues lose their fix version.
+func (r *ReleaseRepoPG) DeleteRelease(ctx context.Context, releaseID string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM releases WHERE id=$1`, releaseID)
+	return err
+}
+
+func (r *ReleaseRepoPG) GetReleaseByID(ctx context.Context, releaseID string) (*models.Release, error) {
+	rel, err := scanRelease(r.db.QueryRow(ctx,
+		releaseSelect+` WHERE r.id=$2`, models.IssueDoneStatuses, releaseID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return rel, err
+}
+
+// ListReleasesByProject lists unreleased versions first, by target date.
+func (r *ReleaseRepoPG) ListReleasesByProject(ctx context.Context, projectID string) ([]models.Release, error) {
+	rows, err := r.db.Query(ctx, releaseSelect+`
+		WHERE r.project_id=$2
+		ORDER BY r.released ASC, r.target_date ASC NULLS LAST, r.created_at ASC
+	`, models.IssueDoneStatuses, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []models.Release{}
+	for rows.Next() {
+		rel, err := scanRelease(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *rel)
+	}
+	return out, rows.Err()
+}
+
+func (r *ReleaseRepoPG) SetFixVersion(ctx context.Context, projectID string, releaseID *string, issueIDs []string) (map[string]*string, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	changed := map[string]*string{}
+	for _, id := range issueIDs {
+		var old *string
+		err := tx.QueryRow(ctx, `
+			SELECT fix_version_id FROM issues
+			WHERE id = $1 AND project_id = $2 AND deleted_at IS NULL
+			FOR UPDATE
+		`, id, projectID).Scan(&old)
+		if err == pgx.ErrNoRows {
+			return nil, errors.New("issue not found in project: " + id)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if models.SameID(old, releaseID) {
+			continue
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE issues SET fix_version_id = $2, version = version + 1, updated_at = NOW()
+			WHERE id = $1
+		`, id, releaseID)
+		if err != nil {
+			return nil, err
+		}
+		changed[id] = old
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return changed, nil
+}
+
+func (r *ReleaseRepoPG) ListChangelogEntries(ctx context.Context, releaseID string) ([]models.ChangelogEntry, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT i.id, i.title, i.issue_type, i.updated_at,
+			COALESCE(ARRAY_AGG(l.name ORDER BY l.name) FILTER (WHERE l.id IS NOT NULL), '{}')
+		FROM issues i
+		LEFT JOIN issue_labels il ON il.issue_id = i.id
+		LEFT JOIN labels l ON l.id = il.label_id
+		WHERE i.fix_version_id = $1 AND i.deleted_at IS NULL AND i.status = ANY($2)
+		GROUP BY i.id
+		ORDER BY i.updated_at ASC, i.id ASC
+	`, releaseID, models.IssueDoneStatuses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []models.ChangelogEntry{}
+	for rows.Next() {
+		var e models.ChangelogEntry
+		if err := rows.Scan(&e.IssueID, &e.Title, &e.IssueType, &e.UpdatedAt, &e.Labels); err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
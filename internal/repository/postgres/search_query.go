@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ToPrefixTSQuery turns free text into a to_tsquery() expression where every
+// word is prefix-matched and all words must be present:
+//
+//	"login crash"  →  "login:* & crash:*"
+//
+// Anything that is not a letter or digit is treated as a separator, so user
+// input can never inject tsquery operators.
+func ToPrefixTSQuery(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, w := range words {
+		terms = append(terms, w+":*")
+	}
+
+	return strings.Join(terms, " & ")
+}
+
+// likePrefix escapes LIKE wildcards in text and appends a trailing %.
+func likePrefix(text string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return r.Replace(text) + "%"
+}
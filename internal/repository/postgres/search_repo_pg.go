@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SearchRepoPG struct {
+	db *pgxpool.Pool
+}
+
+func NewSearchRepository(db *pgxpool.Pool) repo.SearchRepository {
+	return &SearchRepoPG{db: db}
+}
+
+const headlineOptions = `'StartSel=<mark>, StopSel=</mark>, MaxWords=24, MinWords=8, MaxFragments=2'`
+
+// searchArgs hands out positional placeholders on first use, so every bound
+// parameter is referenced by at least one branch of the UNION.
+type searchArgs struct {
+	values       []interface{}
+	placeholders map[string]string
+}
+
+func (a *searchArgs) bind(name string, value interface{}) string {
+	if ph, ok := a.placeholders[name]; ok {
+		return ph
+	}
+	a.values = append(a.values, value)
+	ph := fmt.Sprintf("$%d", len(a.values))
+	a.placeholders[name] = ph
+	return ph
+}
+
+// Search runs one ranked query per requested result type and merges them.
+// Issues match on title/description (plus attachment filenames), comments on
+// their body; projects and users are matched by trigram similarity or prefix.
+func (r *SearchRepoPG) Search(ctx context.Context, customerID string, q models.SearchQuery) ([]models.SearchResult, error) {
+	args := &searchArgs{placeholders: map[string]string{}}
+	customer := args.bind("customer", customerID)
+
+	tsq := func() string { return args.bind("tsquery", ToPrefixTSQuery(q.Text)) }
+	raw := func() string { return args.bind("raw", q.Text) }
+	prefix := func() string { return args.bind("prefix", likePrefix(q.Text)) }
+	scope := func(column string) string {
+		if q.ProjectID == nil {
+			return ""
+		}
+		return " AND " + column + " = " + args.bind("project", *q.ProjectID)
+	}
+
+	var branches []string
+	for _, t := range q.Types {
+		switch t {
+		case models.SearchTypeIssue:
+			branches = append(branches, `
+				SELECT 'issue' AS type, i.id::text AS id, i.project_id::text AS project_id, i.id::text AS issue_id, i.title,
+				       ts_headline('english', coalesce(i.description, ''), tq.query, `+headlineOptions+`) AS snippet,
+				       (ts_rank(i.search_vector, tq.query) + similarity(i.title, `+raw()+`))::float8 AS rank
+				FROM issues i
+				JOIN projects p ON p.id = i.project_id
+				CROSS JOIN (SELECT to_tsquery('english', `+tsq()+`) AS query) tq
+				WHERE p.customer_id = `+customer+scope("i.project_id")+`
+				  AND i.deleted_at IS NULL AND p.deleted_at IS NULL
+				  AND (
+				      i.search_vector @@ tq.query
+				      OR i.title % `+raw()+`
+				      OR EXISTS (
+				          SELECT 1 FROM issue_attachments a
+				          WHERE a.issue_id = i.id AND a.search_vector @@ to_tsquery('simple', `+tsq()+`)
+				      )
+				  )`)
+
+		case models.SearchTypeComment:
+			branches = append(branches, `
+				SELECT 'comment' AS type, c.id::text AS id, i.project_id::text AS project_id, c.issue_id::text AS issue_id, i.title,
+				       ts_headline('english', regexp_replace(c.body, '<[^>]+>', ' ', 'g'), tq.query, `+headlineOptions+`) AS snippet,
+				       ts_rank(c.search_vector, tq.query)::float8 AS rank
+				FROM issue_comments c
+				JOIN issues i ON i.id = c.issue_id
+				JOIN projects p ON p.id = i.project_id
+				CROSS JOIN (SELECT to_tsquery('english', `+tsq()+`) AS query) tq
+				WHERE p.customer_id = `+customer+scope("i.project_id")+`
+				  AND c.deleted_at IS NULL AND i.deleted_at IS NULL AND p.deleted_at IS NULL
+				  AND c.search_vector @@ tq.query`)
+
+		case models.SearchTypeProject:
+			branches = append(branches, `
+				SELECT 'project' AS type, p.id::text AS id, p.id::text AS project_id, NULL AS issue_id, p.name AS title, p.slug AS snippet,
+				       similarity(p.name, `+raw()+`)::float8 AS rank
+				FROM projects p
+				WHERE p.customer_id = `+customer+scope("p.id")+`
+				  AND p.deleted_at IS NULL
+				  AND (p.name % `+raw()+` OR p.name ILIKE `+prefix()+`)`)
+
+		case models.SearchTypeUser:
+			memberScope := ""
+			if q.ProjectID != nil {
+				memberScope = ` AND EXISTS (
+				      SELECT 1 FROM project_members pm
+				      WHERE pm.user_id = u.id AND pm.project_id = ` + args.bind("project", *q.ProjectID) + `)`
+			}
+			branches = append(branches, `
+				SELECT 'user' AS type, u.id::text AS id, NULL AS project_id, NULL AS issue_id, coalesce(u.name, u.username) AS title, u.email AS snippet,
+				       GREATEST(similarity(coalesce(u.name, ''), `+raw()+`), similarity(u.username, `+raw()+`))::float8 AS rank
+				FROM users u
+				WHERE u.customer_id = `+customer+memberScope+`
+				  AND (u.name % `+raw()+` OR u.username % `+raw()+`
+				       OR u.name ILIKE `+prefix()+` OR u.username ILIKE `+prefix()+` OR u.email ILIKE `+prefix()+`)`)
+
+		default:
+			return nil, fmt.Errorf("unknown search type %q", t)
+		}
+	}
+
+	if len(branches) == 0 {
+		return []models.SearchResult{}, nil
+	}
+
+	query := `
+		SELECT type, id, project_id, issue_id, title, snippet, rank
+		FROM (` + strings.Join(branches, "\n\t\t\t\tUNION ALL") + `
+		) results
+		ORDER BY rank DESC
+		LIMIT ` + args.bind("limit", q.Limit) + `
+	`
+
+	rows, err := r.db.Query(ctx, query, args.values...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []models.SearchResult{}
+	for rows.Next() {
+		var res models.SearchResult
+		if err := rows.Scan(
+			&res.Type, &res.ID, &res.ProjectID, &res.IssueID,
+			&res.Title, &res.Snippet, &res.Rank,
+		); err != nil {
+			return nil, err
+		}
+		out = append(out, res)
+	}
+
+	return out, rows.Err()
+}
@@ -0,0 +1,398 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SprintRepoPG struct {
+	db *pgxpool.Pool
+}
+
+func NewSprintRepository(db *pgxpool.Pool) repo.SprintRepository {
+	return &SprintRepoPG{db: db}
+}
+
+const sprintColumns = `
+	id, customer_id, project_id, name, goal, start_date, end_date, state, report,
+	created_by, created_at, updated_at, completed_at
+`
+
+func scanSprint(row pgx.Row) (*models.Sprint, error) {
+	var s models.Sprint
+	err := row.Scan(
+		&s.ID, &s.CustomerID, &s.ProjectID, &s.Name, &s.Goal, &s.StartDate, &s.EndDate, &s.State, &s.Report,
+		&s.CreatedBy, &s.CreatedAt, &s.UpdatedAt, &s.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   SPRINT CRUD
+// ─────────────────────────────────────────────────────────────
+//
+
+func (r *SprintRepoPG) CreateSprint(ctx context.Context, s *models.Sprint) error {
+	s.State = models.SprintPlanned
+	return r.db.QueryRow(ctx, `
+		INSERT INTO sprints (id, customer_id, project_id, name, goal, start_date, end_date, state, created_by, created_at, updated_at)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,NOW(),NOW())
+		RETURNING created_at, updated_at
+	`,
+		s.ID, s.CustomerID, s.ProjectID, s.Name, s.Goal, s.StartDate, s.EndDate, s.State, s.CreatedBy,
+	).Scan(&s.CreatedAt, &s.UpdatedAt)
+}
+
+func (r *SprintRepoPG) UpdateSprint(ctx context.Context, s *models.Sprint) error {
+	return r.db.QueryRow(ctx, `
+		UPDATE sprints
+		SET name=$2, goal=$3, start_date=$4, end_date=$5, updated_at=NOW()
+		WHERE id=$1
+		RETURNING updated_at
+	`, s.ID, s.Name, s.Goal, s.StartDate, s.EndDate).Scan(&s.UpdatedAt)
+}
+
+// DeleteSprint removes the sprint; its issues fall back to the backlog
+// through ON DELETE SET NULL.
+func (r *SprintRepoPG) DeleteSprint(ctx context.Context, sprintID string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM sprints WHERE id=$1`, sprintID)
+	return err
+}
+
+func (r *SprintRepoPG) GetSprintByID(ctx context.Context, sprintID string) (*models.Sprint, error) {
+	s, err := scanSprint(r.db.QueryRow(ctx,
+		`SELECT `+sprintColumns+` FROM sprints WHERE id=$1`, sprintID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return s, err
+}
+
+func (r *SprintRepoPG) GetActiveSprint(ctx context.Context, projectID string) (*models.Sprint, error) {
+	s, err := scanSprint(r.db.QueryRow(ctx,
+		`SELECT `+sprintColumns+` FROM sprints WHERE project_id=$1 AND state=$2`, projectID, models.SprintActive))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return s, err
+}
+
+// ListSprintsByProject lists closed sprints first, then the active one,
+// then planned sprints, each by start date.
+func (r *SprintRepoPG) ListSprintsByProject(ctx context.Context, projectID string) ([]models.Sprint, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+sprintColumns+`
+		FROM sprints
+		WHERE project_id=$1
+		ORDER BY CASE state WHEN 'closed' THEN 0 WHEN 'active' THEN 1 ELSE 2 END,
+			start_date ASC NULLS LAST, created_at ASC
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []models.Sprint{}
+	for rows.Next() {
+		s, err := scanSprint(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *s)
+	}
+	return out, rows.Err()
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   LIFECYCLE
+// ─────────────────────────────────────────────────────────────
+//
+
+func (r *SprintRepoPG) StartSprint(ctx context.Context, sprintID string, start, end time.Time) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var projectID, state string
+	err = tx.QueryRow(ctx, `
+		SELECT project_id, state FROM sprints WHERE id=$1 FOR UPDATE
+	`, sprintID).Scan(&projectID, &state)
+	if err == pgx.ErrNoRows {
+		return models.ErrSprintNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if state != models.SprintPlanned {
+		return errors.New("only planned sprints can be started")
+	}
+
+	var busy bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM sprints WHERE project_id=$1 AND state=$2)
+	`, projectID, models.SprintActive).Scan(&busy)
+	if err != nil {
+		return err
+	}
+	if busy {
+		return errors.New("project already has an active sprint")
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE sprints SET state=$2, start_date=$3, end_date=$4, updated_at=NOW()
+		WHERE id=$1
+	`, sprintID, models.SprintActive, start, end)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *SprintRepoPG) CompleteSprint(ctx context.Context, sprintID string, nextSprintID *string) (*models.SprintReport, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var projectID, state string
+	err = tx.QueryRow(ctx, `
+		SELECT project_id, state FROM sprints WHERE id=$1 FOR UPDATE
+	`, sprintID).Scan(&projectID, &state)
+	if err == pgx.ErrNoRows {
+		return nil, models.ErrSprintNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if state != models.SprintActive {
+		return nil, errors.New("only the active sprint can be completed")
+	}
+
+	if nextSprintID != nil {
+		var nextState string
+		err = tx.QueryRow(ctx, `
+			SELECT state FROM sprints WHERE id=$1 AND project_id=$2
+		`, *nextSprintID, projectID).Scan(&nextState)
+		if err == pgx.ErrNoRows {
+			return nil, errors.New("next sprint not found in project")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if *nextSprintID == sprintID || nextState == models.SprintClosed {
+			return nil, errors.New("next sprint must be open")
+		}
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, title, status, issue_type, story_points
+		FROM issues
+		WHERE sprint_id=$1 AND deleted_at IS NULL
+		ORDER BY backlog_order ASC NULLS LAST, created_at ASC
+		FOR UPDATE
+	`, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.SprintReport{
+		SprintID:     sprintID,
+		Completed:    []models.SprintIssue{},
+		Incomplete:   []models.SprintIssue{},
+		RolledOverTo: nextSprintID,
+	}
+	var unfinished []string
+	for rows.Next() {
+		var si models.SprintIssue
+		if err := rows.Scan(&si.ID, &si.Title, &si.Status, &si.IssueType, &si.StoryPoints); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		points := 0
+		if si.StoryPoints != nil {
+			points = *si.StoryPoints
+		}
+		if models.IsIssueDone(si.Status) {
+			report.Completed = append(report.Completed, si)
+			report.CompletedPoints += points
+		} else {
+			report.Incomplete = append(report.Incomplete, si)
+			report.IncompletePoints += points
+			unfinished = append(unfinished, si.ID)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(unfinished) > 0 {
+		if _, err := moveIssuesTx(ctx, tx, projectID, nextSprintID, unfinished); err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.QueryRow(ctx, `
+		UPDATE sprints SET state=$2, completed_at=NOW(), updated_at=NOW()
+		WHERE id=$1
+		RETURNING completed_at
+	`, sprintID, models.SprintClosed).Scan(&report.CompletedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE sprints SET report=$2 WHERE id=$1`, sprintID, report); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   BACKLOG / SPRINT CONTENT
+// ─────────────────────────────────────────────────────────────
+//
+
+func (r *SprintRepoPG) MoveIssues(ctx context.Context, projectID string, sprintID *string, issueIDs []string) ([]models.SprintMove, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	moves, err := moveIssuesTx(ctx, tx, projectID, sprintID, issueIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return moves, nil
+}
+
+// moveIssuesTx appends the issues, in the given order, to the end of the
+// target list.
+func moveIssuesTx(ctx context.Context, tx pgx.Tx, projectID string, sprintID *string, issueIDs []string) ([]models.SprintMove, error) {
+	var next int
+	err := tx.QueryRow(ctx, `
+		SELECT COALESCE(MAX(backlog_order) + 1, 1)
+		FROM issues
+		WHERE project_id = $1 AND sprint_id IS NOT DISTINCT FROM $2 AND deleted_at IS NULL
+	`, projectID, sprintID).Scan(&next)
+	if err != nil {
+		return nil, err
+	}
+
+	moves := []models.SprintMove{}
+	for _, id := range issueIDs {
+		var from *string
+		err := tx.QueryRow(ctx, `
+			SELECT sprint_id FROM issues
+			WHERE id = $1 AND project_id = $2 AND deleted_at IS NULL
+			FOR UPDATE
+		`, id, projectID).Scan(&from)
+		if err == pgx.ErrNoRows {
+			return nil, errors.New("issue not found in project: " + id)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if models.SameID(from, sprintID) {
+			continue
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE issues SET sprint_id = $2, backlog_order = $3, version = version + 1, updated_at = NOW()
+			WHERE id = $1
+		`, id, sprintID, next)
+		if err != nil {
+			return nil, err
+		}
+		next++
+		moves = append(moves, models.SprintMove{IssueID: id, From: from, To: sprintID})
+	}
+
+	return moves, nil
+}
+
+func (r *SprintRepoPG) ListIssues(ctx context.Context, projectID string, sprintID *string) ([]models.Issue, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+hierarchyColumns+`, i.sprint_id, i.backlog_order
+		FROM issues i
+		WHERE i.project_id = $1 AND i.sprint_id IS NOT DISTINCT FROM $2 AND i.deleted_at IS NULL
+		ORDER BY i.backlog_order ASC NULLS LAST, i.created_at ASC
+	`, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []models.Issue{}
+	for rows.Next() {
+		var i models.Issue
+		err := rows.Scan(
+			&i.ID, &i.ProjectID, &i.ColumnID, &i.Order,
+			&i.Title, &i.Description, &i.Status, &i.Priority,
+			&i.IssueType, &i.ParentIssueID, &i.StoryPoints,
+			&i.OriginalEstimate, &i.RemainingEstimate, &i.CreatedBy,
+			&i.AssignedTo, &i.DueDate, &i.CustomFields, &i.Version, &i.CreatedAt, &i.UpdatedAt,
+			&i.SprintID, &i.BacklogOrder,
+		)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, i)
+	}
+	return out, rows.Err()
+}
+
+func (r *SprintRepoPG) RankIssues(ctx context.Context, projectID string, issueIDs []string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	for pos, id := range issueIDs {
+		tag, err := tx.Exec(ctx, `
+			UPDATE issues SET backlog_order = $3, version = version + 1
+			WHERE id = $1 AND project_id = $2 AND deleted_at IS NULL
+		`, id, projectID, pos+1)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return errors.New("issue not found in project: " + id)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
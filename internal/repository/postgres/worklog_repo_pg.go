@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WorklogRepoPG struct {
+	db *pgxpool.Pool
+}
+
+func NewWorklogRepository(db *pgxpool.Pool) repo.WorklogRepository {
+	return &WorklogRepoPG{db: db}
+}
+
+const worklogColumns = `
+	id, issue_id, user_id, minutes, work_date::text, comment, created_at, updated_at
+`
+
+func scanWorklog(row pgx.Row) (*models.Worklog, error) {
+	var w models.Worklog
+	err := row.Scan(&w.ID, &w.IssueID, &w.UserID, &w.Minutes, &w.WorkDate, &w.Comment, &w.CreatedAt, &w.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+func (r *WorklogRepoPG) CreateWorklog(ctx context.Context, w *models.Worklog) error {
+	return r.db.QueryRow(ctx, `
+		INSERT INTO worklogs (id, issue_id, user_id, minutes, work_date, comment, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5::date, $6, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`, w.ID, w.IssueID, w.UserID, w.Minutes, w.WorkDate, w.Comment).Scan(&w.CreatedAt, &w.UpdatedAt)
+}
+
+func (r *WorklogRepoPG) UpdateWorklog(ctx context.Context, w *models.Worklog) error {
+	return r.db.QueryRow(ctx, `
+		UPDATE worklogs SET minutes=$2, work_date=$3::date, comment=$4, updated_at=NOW()
+		WHERE id=$1
+		RETURNING updated_at
+	`, w.ID, w.Minutes, w.WorkDate, w.Comment).Scan(&w.UpdatedAt)
+}
+
+func (r *WorklogRepoPG) DeleteWorklog(ctx context.Context, worklogID string) error {
+	_, err := r.db.Exec(ctx, `DELETE FROM worklogs WHERE id=$1`, worklogID)
+	return err
+}
+
+func (r *WorklogRepoPG) GetWorklogByID(ctx context.Context, worklogID string) (*models.Worklog, error) {
+	w, err := scanWorklog(r.db.QueryRow(ctx,
+		`SELECT `+worklogColumns+` FROM worklogs WHERE id=$1`, worklogID))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return w, err
+}
+
+func (r *WorklogRepoPG) ListWorklogsByIssue(ctx context.Context, issueID string) ([]models.Worklog, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+worklogColumns+`
+		FROM worklogs
+		WHERE issue_id=$1
+		ORDER BY work_date DESC, created_at DESC
+	`, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []models.Worklog{}
+	for rows.Next() {
+		w, err := scanWorklog(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *w)
+	}
+	return out, rows.Err()
+}
+
+func (r *WorklogRepoPG) ListTimesheet(ctx context.Context, customerID, userID, from, to string, projectID *string) ([]models.TimesheetEntry, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT w.work_date::text, w.issue_id, i.title, i.project_id, SUM(w.minutes)
+		FROM worklogs w
+		JOIN issues i ON i.id = w.issue_id AND i.deleted_at IS NULL
+		JOIN projects p ON p.id = i.project_id AND p.deleted_at IS NULL
+		WHERE w.user_id = $1 AND p.customer_id = $2
+		  AND w.work_date BETWEEN $3::date AND $4::date
+		  AND ($5::uuid IS NULL OR i.project_id = $5)
+		GROUP BY w.work_date, w.issue_id, i.title, i.project_id
+		ORDER BY w.work_date ASC, i.title ASC
+	`, userID, customerID, from, to, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []models.TimesheetEntry{}
+	for rows.Next() {
+		var e models.TimesheetEntry
+		if err := rows.Scan(&e.Date, &e.IssueID, &e.IssueTitle, &e.ProjectID, &e.Minutes); err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
@@ -36,3 +36,23 @@ func (a *ActivityServiceImpl) Log(
 
     return a.repo.Create(ctx, entry)
 }
+
+func (a *ActivityServiceImpl) LogProject(
+    ctx context.Context,
+    projectID string,
+    userID *string,
+    action string,
+    meta map[string]interface{},
+) error {
+
+    entry := &models.IssueActivity{
+        ID:        uuid.NewString(),
+        ProjectID: &projectID,
+        UserID:    userID,
+        Action:    action,
+        Metadata:  meta,
+        CreatedAt: time.Now(),
+    }
+
+    return a.repo.Create(ctx, entry)
+}
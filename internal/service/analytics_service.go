@@ -0,0 +1,526 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	service "bugforge-backend/internal/service/interfaces"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	analyticsDateLayout = "2006-01-02"
+	// defaultFlowDays is the range used when from is not given.
+	defaultFlowDays = 30
+	// maxAnalyticsDays bounds a single report.
+	maxAnalyticsDays = 366
+
+	defaultVelocitySprints = 6
+	maxVelocitySprints     = 50
+)
+
+type AnalyticsServiceImpl struct {
+	analyticsRepo repo.AnalyticsRepository
+	sprintRepo    repo.SprintRepository
+	projectRepo   repo.ProjectRepository
+}
+
+func NewAnalyticsService(
+	analyticsRepo repo.AnalyticsRepository,
+	sprintRepo repo.SprintRepository,
+	projectRepo repo.ProjectRepository,
+) service.AnalyticsService {
+	return &AnalyticsServiceImpl{
+		analyticsRepo: analyticsRepo,
+		sprintRepo:    sprintRepo,
+		projectRepo:   projectRepo,
+	}
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   BURNDOWN / BURNUP
+// ─────────────────────────────────────────────────────────────
+//
+
+// GetBurndown replays sprint membership (sprint_changed) and resolution
+// (status changes) day by day. Story points are taken as they are now.
+// unit is "points" or "issues"; empty picks points when any issue has them.
+// Sprints spanning more than maxAnalyticsDays are refused.
+func (s *AnalyticsServiceImpl) GetBurndown(ctx context.Context, customerID, projectID, sprintID, unit string) (*models.SprintBurndown, error) {
+	sp, err := s.sprintRepo.GetSprintByID(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	if sp == nil || sp.ProjectID != projectID || sp.CustomerID != customerID {
+		return nil, models.ErrSprintNotFound
+	}
+	if sp.StartDate == nil || sp.State == models.SprintPlanned {
+		return nil, errors.New("sprint has not started")
+	}
+	if unit != "" && unit != models.AnalyticsUnitPoints && unit != models.AnalyticsUnitIssues {
+		return nil, errors.New("unit must be points or issues")
+	}
+
+	issues, err := s.analyticsRepo.ListFlowIssues(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	sprintEvents, err := s.analyticsRepo.ListSprintEvents(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	statusEvents, err := s.analyticsRepo.ListStatusEvents(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	membership := groupEvents(sprintEvents)
+	resolution := groupEvents(statusEvents)
+
+	// Candidates: everything that is or ever was in the sprint
+	var scope []models.FlowIssue
+	for _, i := range issues {
+		if _, moved := membership[i.ID]; moved || models.SameID(i.SprintID, &sp.ID) {
+			scope = append(scope, i)
+		}
+	}
+
+	if unit == "" {
+		unit = models.AnalyticsUnitIssues
+		for _, i := range scope {
+			if i.StoryPoints != nil {
+				unit = models.AnalyticsUnitPoints
+				break
+			}
+		}
+	}
+	weight := func(i models.FlowIssue) int {
+		if unit == models.AnalyticsUnitIssues {
+			return 1
+		}
+		if i.StoryPoints == nil {
+			return 0
+		}
+		return *i.StoryPoints
+	}
+
+	inSprint := func(i models.FlowIssue, at time.Time) bool {
+		if i.CreatedAt.After(at) || (i.DeletedAt != nil && !i.DeletedAt.After(at)) {
+			return false
+		}
+		v, known := valueAt(membership[i.ID], at)
+		if !known {
+			return models.SameID(i.SprintID, &sp.ID)
+		}
+		return v != nil && *v == sp.ID
+	}
+	doneAt := func(i models.FlowIssue, at time.Time) bool {
+		v, known := valueAt(resolution[i.ID], at)
+		if !known {
+			return models.IsIssueDone(i.Status)
+		}
+		return v != nil && models.IsIssueDone(*v)
+	}
+
+	start := *sp.StartDate
+	end := start.Add(models.DefaultSprintLength)
+	if sp.EndDate != nil {
+		end = *sp.EndDate
+	}
+	cutoff := time.Now()
+	if sp.CompletedAt != nil {
+		cutoff = *sp.CompletedAt
+		if cutoff.After(end) {
+			end = cutoff
+		}
+	}
+	if tooManyDays(start, end) {
+		return nil, fmt.Errorf("sprint spans more than %d days", maxAnalyticsDays)
+	}
+
+	startScope := 0
+	for _, i := range scope {
+		if inSprint(i, start) {
+			startScope += weight(i)
+		}
+	}
+
+	out := &models.SprintBurndown{SprintID: sp.ID, Unit: unit, Days: []models.BurndownDay{}}
+	days := dayRange(start, end)
+	for n, day := range days {
+		bd := models.BurndownDay{Date: day.Format(analyticsDateLayout)}
+		if len(days) > 1 {
+			bd.Ideal = float64(startScope) * float64(len(days)-1-n) / float64(len(days)-1)
+		}
+
+		if !day.After(cutoff) {
+			at := endOfDay(day)
+			if at.After(cutoff) {
+				at = cutoff
+			}
+			total, done := 0, 0
+			for _, i := range scope {
+				if !inSprint(i, at) {
+					continue
+				}
+				total += weight(i)
+				if doneAt(i, at) {
+					done += weight(i)
+				}
+			}
+			remaining := total - done
+			bd.Scope, bd.Completed, bd.Remaining = &total, &done, &remaining
+		}
+		out.Days = append(out.Days, bd)
+	}
+
+	return out, nil
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   VELOCITY
+// ─────────────────────────────────────────────────────────────
+//
+
+// GetVelocity reads the reports stored on the last n closed sprints.
+func (s *AnalyticsServiceImpl) GetVelocity(ctx context.Context, customerID, projectID string, n int) (*models.Velocity, error) {
+	if err := s.ensureProject(ctx, customerID, projectID); err != nil {
+		return nil, err
+	}
+	if n <= 0 {
+		n = defaultVelocitySprints
+	}
+	if n > maxVelocitySprints {
+		n = maxVelocitySprints
+	}
+
+	sprints, err := s.sprintRepo.ListSprintsByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var closed []models.Sprint
+	for _, sp := range sprints {
+		if sp.State == models.SprintClosed && sp.Report != nil {
+			closed = append(closed, sp)
+		}
+	}
+	sort.Slice(closed, func(a, b int) bool {
+		return closed[a].Report.CompletedAt.Before(closed[b].Report.CompletedAt)
+	})
+	if len(closed) > n {
+		closed = closed[len(closed)-n:]
+	}
+
+	out := &models.Velocity{Sprints: []models.SprintVelocity{}}
+	total := 0
+	for _, sp := range closed {
+		r := sp.Report
+		out.Sprints = append(out.Sprints, models.SprintVelocity{
+			SprintID:        sp.ID,
+			Name:            sp.Name,
+			StartDate:       sp.StartDate,
+			CompletedAt:     sp.CompletedAt,
+			CommittedPoints: r.CompletedPoints + r.IncompletePoints,
+			CompletedPoints: r.CompletedPoints,
+			CommittedIssues: len(r.Completed) + len(r.Incomplete),
+			CompletedIssues: len(r.Completed),
+		})
+		total += r.CompletedPoints
+	}
+	if len(closed) > 0 {
+		out.AverageCompleted = math.Round(float64(total)/float64(len(closed))*100) / 100
+	}
+
+	return out, nil
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   CUMULATIVE FLOW
+// ─────────────────────────────────────────────────────────────
+//
+
+// GetCumulativeFlow replays issue_column_moves. A card without recorded
+// moves is counted in its current column from its creation on; cards in
+// columns that no longer exist are left out.
+func (s *AnalyticsServiceImpl) GetCumulativeFlow(ctx context.Context, customerID, projectID, from, to string) (*models.CumulativeFlow, error) {
+	if err := s.ensureProject(ctx, customerID, projectID); err != nil {
+		return nil, err
+	}
+	start, end, err := parseAnalyticsRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := s.analyticsRepo.ListColumns(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	issues, err := s.analyticsRepo.ListFlowIssues(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	moves, err := s.analyticsRepo.ListColumnMoves(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	byIssue := groupEvents(moves)
+
+	known := map[string]bool{}
+	for _, c := range columns {
+		known[c.ID] = true
+	}
+
+	out := &models.CumulativeFlow{
+		From:    start.Format(analyticsDateLayout),
+		To:      end.Format(analyticsDateLayout),
+		Columns: columns,
+		Days:    []models.FlowDay{},
+	}
+
+	now := time.Now()
+	for _, day := range dayRange(start, end) {
+		at := endOfDay(day)
+		if at.After(now) {
+			at = now
+		}
+
+		counts := map[string]int{}
+		for _, c := range columns {
+			counts[c.ID] = 0
+		}
+		for _, i := range issues {
+			if i.CreatedAt.After(at) || (i.DeletedAt != nil && !i.DeletedAt.After(at)) {
+				continue
+			}
+			col, ok := valueAt(byIssue[i.ID], at)
+			if !ok {
+				col = i.ColumnID
+			}
+			if col != nil && known[*col] {
+				counts[*col]++
+			}
+		}
+		out.Days = append(out.Days, models.FlowDay{Date: day.Format(analyticsDateLayout), Counts: counts})
+	}
+
+	return out, nil
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   LEAD / CYCLE TIME
+// ─────────────────────────────────────────────────────────────
+//
+
+// GetFlowTimes measures the issues whose last resolution falls in the
+// range. Issues resolved without any recorded status change are skipped,
+// as there is no resolution time to measure.
+func (s *AnalyticsServiceImpl) GetFlowTimes(ctx context.Context, customerID, projectID, from, to string) (*models.FlowTimes, error) {
+	if err := s.ensureProject(ctx, customerID, projectID); err != nil {
+		return nil, err
+	}
+	start, end, err := parseAnalyticsRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := s.analyticsRepo.ListFlowIssues(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	statusEvents, err := s.analyticsRepo.ListStatusEvents(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	moves, err := s.analyticsRepo.ListColumnMoves(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	byStatus := groupEvents(statusEvents)
+	byMove := groupEvents(moves)
+
+	out := &models.FlowTimes{
+		From:   start.Format(analyticsDateLayout),
+		To:     end.Format(analyticsDateLayout),
+		Issues: []models.IssueFlowTime{},
+	}
+	rangeEnd := endOfDay(end)
+
+	var leads, cycles []float64
+	for _, i := range issues {
+		if i.DeletedAt != nil || !models.IsIssueDone(i.Status) {
+			continue
+		}
+
+		// Resolution: the start of the current run of done statuses
+		var resolved, started *time.Time
+		for _, e := range byStatus[i.ID] {
+			at := e.At
+			done := e.New != nil && models.IsIssueDone(*e.New)
+			if done && (e.Old == nil || !models.IsIssueDone(*e.Old)) {
+				resolved = &at
+			}
+			if started == nil && e.New != nil && *e.New != "open" {
+				started = &at
+			}
+		}
+		if resolved == nil || resolved.Before(start) || !resolved.Before(rangeEnd) {
+			continue
+		}
+		if ms := byMove[i.ID]; len(ms) > 0 && (started == nil || ms[0].At.Before(*started)) {
+			at := ms[0].At
+			started = &at
+		}
+
+		ft := models.IssueFlowTime{
+			IssueID:    i.ID,
+			Title:      i.Title,
+			ResolvedAt: *resolved,
+			LeadHours:  hoursBetween(i.CreatedAt, *resolved),
+		}
+		leads = append(leads, ft.LeadHours)
+		if started != nil && !started.After(*resolved) {
+			h := hoursBetween(*started, *resolved)
+			ft.CycleHours = &h
+			cycles = append(cycles, h)
+		}
+		out.Issues = append(out.Issues, ft)
+	}
+
+	sort.Slice(out.Issues, func(a, b int) bool {
+		return out.Issues[a].ResolvedAt.Before(out.Issues[b].ResolvedAt)
+	})
+	out.Lead = distribution(leads)
+	out.Cycle = distribution(cycles)
+
+	return out, nil
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   HELPERS
+// ─────────────────────────────────────────────────────────────
+//
+
+func (s *AnalyticsServiceImpl) ensureProject(ctx context.Context, customerID, projectID string) error {
+	pr, err := s.projectRepo.GetByID(ctx, projectID, customerID)
+	if err != nil || pr == nil {
+		return errors.New("project not found")
+	}
+	return nil
+}
+
+// parseAnalyticsRange reads YYYY-MM-DD bounds; to defaults to today and
+// from to defaultFlowDays before it.
+func parseAnalyticsRange(from, to string) (time.Time, time.Time, error) {
+	var err error
+	end := time.Now().UTC().Truncate(24 * time.Hour)
+	if to != "" {
+		if end, err = time.Parse(analyticsDateLayout, to); err != nil {
+			return time.Time{}, time.Time{}, errors.New("invalid to date, expected YYYY-MM-DD")
+		}
+	}
+	start := end.AddDate(0, 0, -(defaultFlowDays - 1))
+	if from != "" {
+		if start, err = time.Parse(analyticsDateLayout, from); err != nil {
+			return time.Time{}, time.Time{}, errors.New("invalid from date, expected YYYY-MM-DD")
+		}
+	}
+	if start.After(end) {
+		return time.Time{}, time.Time{}, errors.New("from must not be after to")
+	}
+	if tooManyDays(start, end) {
+		return time.Time{}, time.Time{}, errors.New("date range is too long")
+	}
+	return start, end, nil
+}
+
+// groupEvents splits events by issue, keeping their order.
+func groupEvents(events []models.IssueEvent) map[string][]models.IssueEvent {
+	out := map[string][]models.IssueEvent{}
+	for _, e := range events {
+		out[e.IssueID] = append(out[e.IssueID], e)
+	}
+	return out
+}
+
+// valueAt replays an issue's ordered events up to at. ok is false when
+// there are no events at all, in which case the current value applies.
+// Before the first event the value is that event's Old.
+func valueAt(events []models.IssueEvent, at time.Time) (*string, bool) {
+	if len(events) == 0 {
+		return nil, false
+	}
+	v := events[0].Old
+	for _, e := range events {
+		if e.At.After(at) {
+			break
+		}
+		v = e.New
+	}
+	return v, true
+}
+
+// dayRange lists the UTC days from start to end, both included, but never
+// more than maxAnalyticsDays of them; callers reject longer ranges first.
+func dayRange(start, end time.Time) []time.Time {
+	first := start.UTC().Truncate(24 * time.Hour)
+	last := end.UTC().Truncate(24 * time.Hour)
+
+	var out []time.Time
+	for d := first; !d.After(last) && len(out) < maxAnalyticsDays; d = d.AddDate(0, 0, 1) {
+		out = append(out, d)
+	}
+	return out
+}
+
+// tooManyDays reports whether dayRange(start, end) would pass
+// maxAnalyticsDays.
+func tooManyDays(start, end time.Time) bool {
+	first := start.UTC().Truncate(24 * time.Hour)
+	last := end.UTC().Truncate(24 * time.Hour)
+	return last.Sub(first) >= maxAnalyticsDays*24*time.Hour
+}
+
+func endOfDay(day time.Time) time.Time {
+	return day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+}
+
+func hoursBetween(a, b time.Time) float64 {
+	return math.Round(b.Sub(a).Hours()*100) / 100
+}
+
+// distribution uses nearest-rank percentiles.
+func distribution(values []float64) models.Distribution {
+	d := models.Distribution{Count: len(values)}
+	if len(values) == 0 {
+		return d
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	d.Mean = math.Round(sum/float64(len(sorted))*100) / 100
+
+	rank := func(p float64) float64 {
+		n := int(math.Ceil(p / 100 * float64(len(sorted))))
+		if n < 1 {
+			n = 1
+		}
+		return sorted[n-1]
+	}
+	d.P50, d.P85, d.P95 = rank(50), rank(85), rank(95)
+	return d
+}
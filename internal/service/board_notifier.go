@@ -0,0 +1,97 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	ws "bugforge-backend/internal/websocket"
+	"context"
+	"encoding/json"
+)
+
+// boardNotifier pushes issue changes made outside the kanban routes
+// (progress rollups, cards appearing or disappearing) to the kanban rooms
+// of every board of the project so boards update without a reload.
+type boardNotifier struct {
+	issueRepo repo.IssueRepository
+	hub       *ws.Hub
+}
+
+func (p boardNotifier) send(projectID string, evt map[string]any) {
+	if p.hub == nil {
+		return
+	}
+	b, _ := json.Marshal(evt)
+	p.hub.BroadcastProject(projectID, b)
+}
+
+// wipWarning tells the boards that cardID pushed its column past a soft
+// WIP limit.
+func (p boardNotifier) wipWarning(projectID, cardID string, w *models.WIPWarning) {
+	if w == nil {
+		return
+	}
+	p.send(projectID, map[string]any{
+		"type":      "wip_limit_exceeded",
+		"projectID": projectID,
+		"payload": map[string]any{
+			"card_id": cardID,
+			"warning": w,
+		},
+	})
+}
+
+func (p boardNotifier) issueChanged(ctx context.Context, issueID string) {
+	if p.hub == nil {
+		return
+	}
+
+	iss, err := p.issueRepo.GetByID(ctx, issueID)
+	if err != nil || iss == nil {
+		return
+	}
+
+	byIssue, err := p.issueRepo.GetProgress(ctx, []string{issueID})
+	if err != nil {
+		return
+	}
+	progress := byIssue[issueID]
+	if progress == nil {
+		progress = models.NewIssueProgress(0, 0, 0, 0)
+	}
+
+	p.send(iss.ProjectID, map[string]any{
+		"type":      "issue_progress",
+		"projectID": iss.ProjectID,
+		"issueID":   issueID,
+		"progress":  progress,
+	})
+}
+
+func (p boardNotifier) checklistChanged(ctx context.Context, checklistID string) {
+	cl, err := p.issueRepo.GetChecklistByID(ctx, checklistID)
+	if err != nil || cl == nil {
+		return
+	}
+	p.issueChanged(ctx, cl.IssueID)
+}
+
+// attachProgress fills Progress on a page of listed issues.
+func attachProgress(ctx context.Context, issueRepo repo.IssueRepository, issues []models.IssueWithUser) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(issues))
+	for i := range issues {
+		ids[i] = issues[i].ID
+	}
+
+	byIssue, err := issueRepo.GetProgress(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for i := range issues {
+		issues[i].Progress = byIssue[issues[i].ID]
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	service "bugforge-backend/internal/service/interfaces"
+	ws "bugforge-backend/internal/websocket"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type ChecklistTemplateServiceImpl struct {
+	templateRepo repo.ChecklistTemplateRepository
+	issueRepo    repo.IssueRepository
+	projectRepo  repo.ProjectRepository
+	activity     service.ActivityService
+	board        boardNotifier
+}
+
+func NewChecklistTemplateService(
+	templateRepo repo.ChecklistTemplateRepository,
+	issueRepo repo.IssueRepository,
+	projectRepo repo.ProjectRepository,
+	activitySvc service.ActivityService,
+	boardHub *ws.Hub,
+) service.ChecklistTemplateService {
+	return &ChecklistTemplateServiceImpl{
+		templateRepo: templateRepo,
+		issueRepo:    issueRepo,
+		projectRepo:  projectRepo,
+		activity:     activitySvc,
+		board:        boardNotifier{issueRepo: issueRepo, hub: boardHub},
+	}
+}
+
+func (s *ChecklistTemplateServiceImpl) CreateTemplate(ctx context.Context, customerID, projectID, name, title string, items []string, userID string) (*models.ChecklistTemplate, error) {
+	if err := s.ensureProject(ctx, customerID, projectID); err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+	if strings.TrimSpace(title) == "" {
+		title = name
+	}
+
+	items, err := cleanTemplateItems(items)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &models.ChecklistTemplate{
+		ID:         uuid.NewString(),
+		CustomerID: customerID,
+		ProjectID:  projectID,
+		Name:       name,
+		Title:      title,
+		Items:      items,
+		CreatedBy:  userID,
+	}
+
+	if err := s.templateRepo.CreateTemplate(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *ChecklistTemplateServiceImpl) UpdateTemplate(ctx context.Context, customerID, projectID, templateID string, name, title *string, items []string, userID string) (*models.ChecklistTemplate, error) {
+	t, err := s.GetTemplate(ctx, customerID, projectID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != nil {
+		if strings.TrimSpace(*name) == "" {
+			return nil, errors.New("name cannot be empty")
+		}
+		t.Name = strings.TrimSpace(*name)
+	}
+	if title != nil {
+		if strings.TrimSpace(*title) == "" {
+			return nil, errors.New("title cannot be empty")
+		}
+		t.Title = *title
+	}
+	if items != nil {
+		if t.Items, err = cleanTemplateItems(items); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.templateRepo.UpdateTemplate(ctx, t, userID); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *ChecklistTemplateServiceImpl) DeleteTemplate(ctx context.Context, customerID, projectID, templateID, userID string) error {
+	if _, err := s.GetTemplate(ctx, customerID, projectID, templateID); err != nil {
+		return err
+	}
+	return s.templateRepo.DeleteTemplate(ctx, templateID)
+}
+
+func (s *ChecklistTemplateServiceImpl) GetTemplate(ctx context.Context, customerID, projectID, templateID string) (*models.ChecklistTemplate, error) {
+	t, err := s.templateRepo.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.ProjectID != projectID || t.CustomerID != customerID {
+		return nil, models.ErrChecklistTemplateNotFound
+	}
+	return t, nil
+}
+
+func (s *ChecklistTemplateServiceImpl) ListTemplatesByProject(ctx context.Context, customerID, projectID string) ([]models.ChecklistTemplate, error) {
+	if err := s.ensureProject(ctx, customerID, projectID); err != nil {
+		return nil, err
+	}
+	return s.templateRepo.ListTemplatesByProject(ctx, projectID)
+}
+
+func (s *ChecklistTemplateServiceImpl) ListVersions(ctx context.Context, customerID, projectID, templateID string) ([]models.ChecklistTemplateVersion, error) {
+	if _, err := s.GetTemplate(ctx, customerID, projectID, templateID); err != nil {
+		return nil, err
+	}
+	return s.templateRepo.ListVersions(ctx, templateID)
+}
+
+func (s *ChecklistTemplateServiceImpl) ApplyTemplate(ctx context.Context, customerID, issueID, templateID string, version *int, userID string) (*models.Checklist, error) {
+	iss, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil || iss == nil {
+		return nil, errors.New("issue not found")
+	}
+
+	t, err := s.GetTemplate(ctx, customerID, iss.ProjectID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	v := t.Version
+	if version != nil {
+		v = *version
+	}
+
+	cl, err := s.templateRepo.Apply(ctx, templateID, v, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = s.activity.Log(ctx, issueID, &userID, models.ActivityChecklistCreated, map[string]interface{}{
+		"checklist_id":     cl.ID,
+		"title":            cl.Title,
+		"template_id":      templateID,
+		"template_version": v,
+		"items":            len(cl.Items),
+	})
+
+	s.board.issueChanged(ctx, issueID)
+
+	return cl, nil
+}
+
+func (s *ChecklistTemplateServiceImpl) ensureProject(ctx context.Context, customerID, projectID string) error {
+	pr, err := s.projectRepo.GetByID(ctx, projectID, customerID)
+	if err != nil || pr == nil {
+		return errors.New("project not found")
+	}
+	return nil
+}
+
+// cleanTemplateItems trims the items and rejects blank ones.
+func cleanTemplateItems(items []string) ([]string, error) {
+	out := make([]string, 0, len(items))
+	for _, it := range items {
+		it = strings.TrimSpace(it)
+		if it == "" {
+			return nil, errors.New("checklist items cannot be empty")
+		}
+		out = append(out, it)
+	}
+	return out, nil
+}
@@ -6,4 +6,7 @@ import (
 
 type ActivityService interface {
     Log(ctx context.Context, issueID string, userID *string, action string, meta map[string]interface{}) error
+    // LogProject records an event that belongs to the project rather than
+    // to one issue; it appears in the project feed only.
+    LogProject(ctx context.Context, projectID string, userID *string, action string, meta map[string]interface{}) error
 }
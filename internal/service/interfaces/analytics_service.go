@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+// AnalyticsService derives project reports from issue history. Dates are
+// YYYY-MM-DD; empty bounds fall back to the last 30 days.
+type AnalyticsService interface {
+	GetBurndown(ctx context.Context, customerID, projectID, sprintID, unit string) (*models.SprintBurndown, error)
+	GetVelocity(ctx context.Context, customerID, projectID string, sprints int) (*models.Velocity, error)
+	GetCumulativeFlow(ctx context.Context, customerID, projectID, from, to string) (*models.CumulativeFlow, error)
+	GetFlowTimes(ctx context.Context, customerID, projectID, from, to string) (*models.FlowTimes, error)
+}
@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type ChecklistTemplateService interface {
+	CreateTemplate(ctx context.Context, customerID, projectID, name, title string, items []string, userID string) (*models.ChecklistTemplate, error)
+	// UpdateTemplate creates a new version when title or items change;
+	// checklists applied from older versions are left as they are.
+	UpdateTemplate(ctx context.Context, customerID, projectID, templateID string, name, title *string, items []string, userID string) (*models.ChecklistTemplate, error)
+	DeleteTemplate(ctx context.Context, customerID, projectID, templateID, userID string) error
+	GetTemplate(ctx context.Context, customerID, projectID, templateID string) (*models.ChecklistTemplate, error)
+	ListTemplatesByProject(ctx context.Context, customerID, projectID string) ([]models.ChecklistTemplate, error)
+	ListVersions(ctx context.Context, customerID, projectID, templateID string) ([]models.ChecklistTemplateVersion, error)
+
+	// ApplyTemplate adds the template (current version unless one is given)
+	// to an issue as a new checklist.
+	ApplyTemplate(ctx context.Context, customerID, issueID, templateID string, version *int, userID string) (*models.Checklist, error)
+}
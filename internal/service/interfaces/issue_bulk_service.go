@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type IssueBulkService interface {
+	Apply(ctx context.Context, customerID, projectID, actorID string, req models.BulkIssueRequest) (*models.BulkResult, error)
+}
@@ -9,13 +9,31 @@ import (
 
 type IssueService interface {
 	// ─────────── Core Issue ───────────
-	CreateIssue(ctx context.Context, customerID, projectID, title, description, priority string, assignedTo *string, actorUserID string) (*models.Issue, error)
-	ListAllIssues(ctx context.Context, customerID string) ([]models.IssueWithUser, error)
+	CreateIssue(ctx context.Context, customerID, projectID, title, description, priority, issueType string, assignedTo *string, parentIssueID *string, templateID *string, actorUserID string) (*models.Issue, error)
+	ListAllIssues(ctx context.Context, customerID string, page models.PageRequest) ([]models.IssueWithUser, models.PageInfo, error)
 	GetIssue(ctx context.Context, customerID, issueID string) (*models.Issue, error)
 	ListIssuesByProject(ctx context.Context, projectID, customerID string, q url.Values) ([]models.IssueWithUser, error)
-	UpdateIssue(ctx context.Context, customerID, issueID string, title, description, status, priority string, assignedTo *string, actorUserID string) (*models.Issue, error)
+	// Updates take the version the client edited (nil = unconditional) and
+	// fail with *models.VersionConflictError when it is stale.
+	UpdateIssue(ctx context.Context, customerID, issueID string, patch models.IssuePatch, expectedVersion *int, actorUserID string) (*models.Issue, error)
 	DeleteIssue(ctx context.Context, customerID, issueID, actorUserID string) error
 
+	// ─────────── Move / Clone ───────────
+	MoveIssue(ctx context.Context, customerID, issueID string, req models.MoveIssueRequest, actorUserID string) (*models.MoveIssueResult, error)
+	CloneIssue(ctx context.Context, customerID, issueID string, req models.CloneIssueRequest, actorUserID string) (*models.Issue, error)
+
+	// ─────────── Hierarchy ───────────
+	GetIssueTree(ctx context.Context, customerID, issueID string) (*models.IssueTree, error)
+	GetIssueRollup(ctx context.Context, customerID, issueID string) (*models.IssueRollup, error)
+
+	// ─────────── Subtask <-> Issue ───────────
+	PromoteSubtask(ctx context.Context, customerID, parentIssueID, subtaskID string, req models.PromoteSubtaskRequest, userID string) (*models.Issue, error)
+	DemoteIssue(ctx context.Context, customerID, issueID string, req models.DemoteIssueRequest, userID string) (*models.Subtask, error)
+
+	// ─────────── Trash ───────────
+	ListTrash(ctx context.Context, customerID, projectID string) ([]models.TrashItem, error)
+	RestoreIssue(ctx context.Context, customerID, issueID, actorUserID string) (*models.Issue, error)
+
 	// ─────────── Due Date ───────────
 	UpdateDueDate(ctx context.Context, customerID, issueID string, dueDate *time.Time, userID string) error
 
@@ -26,9 +44,10 @@ type IssueService interface {
 
 	// ─────────── Comments ───────────
 	CreateComment(ctx context.Context, customerID, issueID, userID, body string) (*models.IssueComment, error)
-	ListComments(ctx context.Context, customerID, issueID string) ([]models.IssueComment, error)
-	UpdateComment(ctx context.Context, customerID, commentID, userID, body string) (*models.IssueComment, error)
+	ListComments(ctx context.Context, customerID, issueID string, page models.PageRequest) ([]models.IssueComment, models.PageInfo, error)
+	UpdateComment(ctx context.Context, customerID, commentID, userID, body string, expectedVersion *int) (*models.IssueComment, error)
 	DeleteComment(ctx context.Context, customerID, commentID, userID string) error
+	RestoreComment(ctx context.Context, customerID, commentID, userID string) (*models.IssueComment, error)
 
 	// ─────────── Attachments ───────────
 	AddAttachment(ctx context.Context, customerID, issueID, userID string, att *models.IssueAttachment) error
@@ -38,7 +57,7 @@ type IssueService interface {
 	// ─────────── Checklists ───────────
 	CreateChecklist(ctx context.Context, customerID, issueID, title, userID string) (*models.Checklist, error)
 	CreateChecklistItem(ctx context.Context, customerID, checklistID, content string, userID string) (*models.ChecklistItem, error)
-	UpdateChecklistItem(ctx context.Context, customerID, itemID string, content string, done bool, userID string) (*models.ChecklistItem, error)
+	UpdateChecklistItem(ctx context.Context, customerID, itemID string, content string, done bool, expectedVersion *int, userID string) (*models.ChecklistItem, error)
 	ListChecklists(ctx context.Context, customerID, issueID string) ([]models.Checklist, error)
 	DeleteChecklist(ctx context.Context, customerID, checklistID string) error
 	DeleteChecklistItem(ctx context.Context, customerID, itemID string) error
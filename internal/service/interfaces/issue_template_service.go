@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type IssueTemplateService interface {
+	CreateTemplate(ctx context.Context, customerID, projectID string, t *models.IssueTemplate, userID string) (*models.IssueTemplate, error)
+	UpdateTemplate(ctx context.Context, customerID, projectID, templateID string, t *models.IssueTemplate, userID string) (*models.IssueTemplate, error)
+	DeleteTemplate(ctx context.Context, customerID, projectID, templateID, userID string) error
+	GetTemplate(ctx context.Context, customerID, projectID, templateID string) (*models.IssueTemplate, error)
+	ListTemplatesByProject(ctx context.Context, customerID, projectID string) ([]models.IssueTemplate, error)
+}
@@ -1,17 +1,33 @@
 package interfaces
 
 import (
+	"net/url"
+
 	"bugforge-backend/internal/models"
 )
 
 type KanbanService interface {
-    GetBoard(projectID string, userID string) (*models.KanbanBoard, error)
+    GetBoard(projectID, boardID string, userID string, q url.Values) (*models.KanbanBoard, error)
     MoveCard(
     cardID string,
     toColumnID string,
     newOrder int,
+    lane *models.LaneMove,
     userID string,
-) (*models.Issue, string, error) 
-    CreateCard(projectID, columnID, title, description, userID string) (*models.Issue, error)
-    CreateColumn(projectID, name string, userID string) (*models.KanbanColumn, error)
+) (*models.Issue, string, *models.WIPWarning, error)
+    CreateCard(projectID, columnID, title, description string, templateID *string, userID string) (*models.Issue, *models.WIPWarning, error)
+    CreateColumn(projectID, boardID, name string, userID string) (*models.KanbanColumn, error)
+    SetColumnWIPLimit(projectID, columnID string, limit *int, mode, userID string) (*models.KanbanColumn, error)
+    ArchiveColumn(projectID, columnID, userID string) (*models.KanbanColumn, error)
+    UnarchiveColumn(projectID, columnID, userID string) (*models.KanbanColumn, error)
+
+    ListQuickFilters(projectID, userID string) ([]models.QuickFilter, error)
+    CreateQuickFilter(projectID, name string, filter models.BoardFilter, userID string) (*models.QuickFilter, error)
+    UpdateQuickFilter(projectID, filterID, name string, filter models.BoardFilter, userID string) (*models.QuickFilter, error)
+    DeleteQuickFilter(projectID, filterID, userID string) error
+
+    ListBoards(projectID, userID string) ([]models.Board, error)
+    CreateBoard(projectID, name string, filter models.BoardFilter, userID string) (*models.Board, error)
+    UpdateBoard(projectID, boardID, name string, filter models.BoardFilter, userID string) (*models.Board, error)
+    DeleteBoard(projectID, boardID, userID string) error
 }
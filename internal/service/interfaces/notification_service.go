@@ -1,5 +1,7 @@
 package interfaces
 
+import "bugforge-backend/internal/models"
+
 type NotificationView struct {
 	ID        string `json:"id"`
 	UserID    string `json:"user_id"`
@@ -15,6 +17,6 @@ type NotificationService interface {
 	SendInApp(userID, title, message, metadata string) error
 	SendEmail(userID, title, message string) error
 	MarkAsRead(notificationID string) error
-	GetUserNotifications(userID string) ([]NotificationView, error)
+	GetUserNotifications(userID string, page models.PageRequest) ([]NotificationView, models.PageInfo, error)
 	MarkAllAsRead(userID string) error
 }
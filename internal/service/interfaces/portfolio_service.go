@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type PortfolioService interface {
+	CreatePortfolio(ctx context.Context, customerID string, p *models.Portfolio, userID string) (*models.Portfolio, error)
+	UpdatePortfolio(ctx context.Context, customerID, portfolioID string, p *models.Portfolio, userID string) (*models.Portfolio, error)
+	DeletePortfolio(ctx context.Context, customerID, portfolioID, userID string) error
+	GetPortfolio(ctx context.Context, customerID, portfolioID string) (*models.Portfolio, error)
+	ListPortfolios(ctx context.Context, customerID string) ([]models.Portfolio, error)
+
+	GetBoard(ctx context.Context, customerID, portfolioID, userID string) (*models.PortfolioBoard, error)
+	// MoveCard drops a card into a portfolio column; order is the 1-based
+	// index in the portfolio column as the viewer sees it (0 appends).
+	MoveCard(ctx context.Context, customerID, portfolioID, cardID, portfolioColumnID string, order int, userID string) (*models.Issue, error)
+	// ViewerProjects returns the portfolio projects the user is a member of.
+	ViewerProjects(ctx context.Context, customerID, portfolioID, userID string) ([]string, error)
+}
@@ -8,6 +8,6 @@ import (
 type ProjectMemberService interface {
     AddMember(ctx context.Context, projectID, customerID, userID string) error
     RemoveMember(ctx context.Context, projectID, customerID, userID string) error
-    ListMembers(ctx context.Context, projectID, customerID string) ([]models.User, error)
+    ListMembers(ctx context.Context, projectID, customerID string, page models.PageRequest) ([]models.User, models.PageInfo, error)
     Invite(ctx context.Context, projectID, customerID, email, role string) error
 }
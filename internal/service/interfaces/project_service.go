@@ -10,6 +10,8 @@ type ProjectService interface {
     GetProjects(ctx context.Context, customerID string) ([]models.Project, error)
     GetProjectByID(ctx context.Context, id, customerID string) (*models.Project, error)
     UpdateProject(ctx context.Context, id, customerID, name, slug string) (*models.Project, error)
-    DeleteProject(ctx context.Context, id, customerID string) error
-    ListProjectActivity(ctx context.Context, customerID, projectID string) ([]models.IssueActivity, error)
+    DeleteProject(ctx context.Context, id, customerID, userID string) error
+    ListDeletedProjects(ctx context.Context, customerID string) ([]models.Project, error)
+    RestoreProject(ctx context.Context, id, customerID string) (*models.Project, error)
+    ListProjectActivity(ctx context.Context, customerID, projectID string, page models.PageRequest) ([]models.IssueActivity, models.PageInfo, error)
 }
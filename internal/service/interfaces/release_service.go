@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type ReleaseService interface {
+	CreateRelease(ctx context.Context, customerID, projectID string, r *models.Release, userID string) (*models.Release, error)
+	UpdateRelease(ctx context.Context, customerID, projectID, releaseID string, r *models.Release, userID string) (*models.Release, error)
+	DeleteRelease(ctx context.Context, customerID, projectID, releaseID, userID string) error
+	GetRelease(ctx context.Context, customerID, projectID, releaseID string) (*models.Release, error)
+	ListReleases(ctx context.Context, customerID, projectID string) ([]models.Release, error)
+
+	AddIssues(ctx context.Context, customerID, projectID, releaseID string, issueIDs []string, userID string) (int, error)
+	RemoveIssue(ctx context.Context, customerID, projectID, releaseID, issueID, userID string) error
+
+	GetChangelog(ctx context.Context, customerID, projectID, releaseID string) (*models.Changelog, error)
+	// RenderChangelog returns the changelog as "markdown" or "html" together
+	// with its content type.
+	RenderChangelog(ctx context.Context, customerID, projectID, releaseID, format string) (string, string, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, customerID, text string, types []string, projectID *string, limit int) ([]models.SearchResult, error)
+}
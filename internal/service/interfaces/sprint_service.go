@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type SprintService interface {
+	CreateSprint(ctx context.Context, customerID, projectID string, s *models.Sprint, userID string) (*models.Sprint, error)
+	UpdateSprint(ctx context.Context, customerID, projectID, sprintID string, s *models.Sprint, userID string) (*models.Sprint, error)
+	DeleteSprint(ctx context.Context, customerID, projectID, sprintID, userID string) error
+	GetSprint(ctx context.Context, customerID, projectID, sprintID string) (*models.Sprint, error)
+	ListSprints(ctx context.Context, customerID, projectID string) ([]models.Sprint, error)
+
+	StartSprint(ctx context.Context, customerID, projectID, sprintID, userID string) (*models.Sprint, error)
+	CompleteSprint(ctx context.Context, customerID, projectID, sprintID string, req models.CompleteSprintRequest, userID string) (*models.SprintReport, error)
+
+	// sprintID nil means the backlog.
+	ListSprintIssues(ctx context.Context, customerID, projectID string, sprintID *string) ([]models.Issue, error)
+	MoveIssues(ctx context.Context, customerID, projectID string, sprintID *string, issueIDs []string, userID string) ([]models.SprintMove, error)
+	RankIssues(ctx context.Context, customerID, projectID string, sprintID *string, issueIDs []string) error
+}
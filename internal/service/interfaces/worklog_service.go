@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+)
+
+type WorklogService interface {
+	LogWork(ctx context.Context, customerID, issueID string, w *models.Worklog, userID string) (*models.Worklog, error)
+	UpdateWorklog(ctx context.Context, customerID, issueID, worklogID string, w *models.Worklog, userID string) (*models.Worklog, error)
+	DeleteWorklog(ctx context.Context, customerID, issueID, worklogID, userID string) error
+	ListWorklogs(ctx context.Context, customerID, issueID string) ([]models.Worklog, error)
+
+	// GetTimesheet reports what a user logged between from and to
+	// (YYYY-MM-DD, inclusive); projectID may be empty.
+	GetTimesheet(ctx context.Context, customerID, userID, from, to, projectID string) (*models.Timesheet, error)
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	service "bugforge-backend/internal/service/interfaces"
+	ws "bugforge-backend/internal/websocket"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBulkNoTarget      = errors.New("either issue_ids or query is required")
+	ErrBulkBothTargets   = errors.New("issue_ids and query are mutually exclusive")
+	ErrBulkNoChanges     = errors.New("no changes requested")
+	ErrBulkDeleteMixed   = errors.New("delete cannot be combined with other changes")
+	ErrBulkTooManyIssues = fmt.Errorf("a bulk operation is limited to %d issues", models.MaxBulkIssues)
+)
+
+type IssueBulkServiceImpl struct {
+	issueRepo     repo.IssueRepository
+	projectRepo   repo.ProjectRepository
+	userRepo      repo.UserRepository
+	labelRepo     repo.LabelRepository
+	activity      service.ActivityService
+	notifications service.NotificationService
+	hub           *ws.Hub
+}
+
+func NewIssueBulkService(
+	issueRepo repo.IssueRepository,
+	projectRepo repo.ProjectRepository,
+	userRepo repo.UserRepository,
+	labelRepo repo.LabelRepository,
+	activitySvc service.ActivityService,
+	notifSvc service.NotificationService,
+	hub *ws.Hub,
+) service.IssueBulkService {
+	return &IssueBulkServiceImpl{
+		issueRepo:     issueRepo,
+		projectRepo:   projectRepo,
+		userRepo:      userRepo,
+		labelRepo:     labelRepo,
+		activity:      activitySvc,
+		notifications: notifSvc,
+		hub:           hub,
+	}
+}
+
+// Apply runs one bulk change over a set of issues of a project. Issues are
+// picked either by id or by a list query; the change itself is applied in a
+// single transaction and reported per issue.
+func (s *IssueBulkServiceImpl) Apply(
+	ctx context.Context,
+	customerID, projectID, actorID string,
+	req models.BulkIssueRequest,
+) (*models.BulkResult, error) {
+	pr, err := s.projectRepo.GetByID(ctx, projectID, customerID)
+	if err != nil || pr == nil {
+		return nil, errors.New("project not found")
+	}
+
+	if err := s.validateChange(ctx, customerID, projectID, req.Changes); err != nil {
+		return nil, err
+	}
+
+	ids, err := s.resolveTargets(ctx, projectID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	batchID := uuid.New().String()
+
+	items, err := s.issueRepo.BulkApply(ctx, projectID, ids, req.Changes, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &models.BulkResult{
+		BatchID: batchID,
+		Total:   len(items),
+		Results: items,
+	}
+
+	// user id -> titles of the issues that changed for them
+	digest := map[string][]string{}
+	changed := []string{}
+	changes := map[string]map[string]models.FieldChange{}
+
+	for _, it := range items {
+		if !it.OK {
+			out.Failed++
+			continue
+		}
+		out.Succeeded++
+
+		if len(it.Changes) == 0 {
+			continue
+		}
+		changed = append(changed, it.IssueID)
+		changes[it.IssueID] = it.Changes
+
+		action := models.ActivityBulkUpdated
+		if req.Changes.Delete {
+			action = models.ActivityDeleted
+		}
+		_ = s.activity.Log(ctx, it.IssueID, &actorID, action, map[string]interface{}{
+			"batch_id": batchID,
+			"changes":  it.Changes,
+		})
+
+		for _, uid := range it.Assignees {
+			if uid == actorID {
+				continue
+			}
+			digest[uid] = append(digest[uid], it.Title)
+		}
+	}
+
+	for uid, titles := range digest {
+		b, _ := json.Marshal(map[string]interface{}{
+			"batch_id":   batchID,
+			"project_id": projectID,
+			"issues":     titles,
+		})
+		_ = s.notifications.SendInApp(
+			uid,
+			"Issues updated",
+			fmt.Sprintf("%d issue(s) in %s were updated in bulk", len(titles), pr.Name),
+			string(b),
+		)
+	}
+
+	if len(changed) > 0 {
+		evt := map[string]any{
+			"type":      "issues_bulk_updated",
+			"projectID": projectID,
+			"batch_id":  batchID,
+			"actor_id":  actorID,
+			"issue_ids": changed,
+			"changes":   changes,
+		}
+		b, _ := json.Marshal(evt)
+		s.hub.BroadcastProject(projectID, b)
+	}
+
+	// Cards moved past a soft WIP limit are flagged one by one, the same
+	// way a single move on the board is.
+	for _, it := range items {
+		if !it.OK || it.WIPWarning == nil {
+			continue
+		}
+		b, _ := json.Marshal(map[string]any{
+			"type":      "wip_limit_exceeded",
+			"projectID": projectID,
+			"payload": map[string]any{
+				"card_id": it.IssueID,
+				"warning": it.WIPWarning,
+			},
+		})
+		s.hub.BroadcastProject(projectID, b)
+	}
+
+	return out, nil
+}
+
+func (s *IssueBulkServiceImpl) validateChange(ctx context.Context, customerID, projectID string, ch models.BulkIssueChange) error {
+	if ch.Empty() {
+		return ErrBulkNoChanges
+	}
+	if ch.Delete {
+		del := ch
+		del.Delete = false
+		if !del.Empty() {
+			return ErrBulkDeleteMixed
+		}
+		return nil
+	}
+
+	if ch.Status != nil && !validStatuses[*ch.Status] {
+		return ErrInvalidStatus
+	}
+	if ch.Priority != nil && !validPriorities[*ch.Priority] {
+		return ErrInvalidPriority
+	}
+
+	if ch.AssignedTo.Set && !ch.AssignedTo.Null {
+		u, err := s.userRepo.GetByID(ctx, ch.AssignedTo.Value)
+		if err != nil || u == nil || u.CustomerID != customerID {
+			return errors.New("assignee not found")
+		}
+	}
+
+	for _, id := range append(append([]string{}, ch.AddLabels...), ch.RemoveLabels...) {
+		l, err := s.labelRepo.GetLabelByID(ctx, id)
+		if err != nil || l == nil || l.ProjectID != projectID {
+			return fmt.Errorf("label %s not found in project", id)
+		}
+	}
+
+	return nil
+}
+
+func (s *IssueBulkServiceImpl) resolveTargets(ctx context.Context, projectID string, req models.BulkIssueRequest) ([]string, error) {
+	if len(req.IssueIDs) > 0 && req.Query != nil {
+		return nil, ErrBulkBothTargets
+	}
+
+	var ids []string
+
+	switch {
+	case len(req.IssueIDs) > 0:
+		seen := map[string]bool{}
+		for _, id := range req.IssueIDs {
+			if id == "" || seen[id] {
+				continue
+			}
+			seen[id] = true
+			ids = append(ids, id)
+		}
+
+	case req.Query != nil:
+		q := req.Query
+		if q.Status != nil && !validStatuses[*q.Status] {
+			return nil, ErrInvalidStatus
+		}
+		if q.Priority != nil && !validPriorities[*q.Priority] {
+			return nil, ErrInvalidPriority
+		}
+		issues, err := s.issueRepo.ListByProject(ctx, projectID, repo.IssueFilter{
+			Status:     q.Status,
+			Priority:   q.Priority,
+			AssignedTo: q.AssignedTo,
+			Search:     q.Search,
+			Limit:      models.MaxBulkIssues + 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, iss := range issues {
+			ids = append(ids, iss.ID)
+		}
+
+	default:
+		return nil, ErrBulkNoTarget
+	}
+
+	if len(ids) == 0 {
+		return nil, errors.New("no issues matched")
+	}
+	if len(ids) > models.MaxBulkIssues {
+		return nil, ErrBulkTooManyIssues
+	}
+	return ids, nil
+}
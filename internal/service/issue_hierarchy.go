@@ -0,0 +1,175 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+	"errors"
+)
+
+//
+// ─────────────────────────────────────────────────────────────
+//   HIERARCHY (EPIC > STORY > TASK)
+// ─────────────────────────────────────────────────────────────
+//
+
+var errEpicWithParent = errors.New("an epic cannot have a parent issue")
+
+// checkParent validates parentID as the parent of child: same tenant and
+// project, child not an epic, and child not already an ancestor of parentID.
+// The ancestor check is repeated under row locks when Update writes the
+// parent, which catches concurrent re-parentings closing a cycle.
+func (s *IssueServiceImpl) checkParent(ctx context.Context, customerID string, child *models.Issue, parentID string) (*models.Issue, error) {
+	if child.IssueType == models.IssueTypeEpic {
+		return nil, errEpicWithParent
+	}
+	if parentID == child.ID {
+		return nil, models.ErrIssueCycle
+	}
+
+	parent, err := s.ensureIssueAndTenant(ctx, customerID, parentID)
+	if err != nil {
+		return nil, errors.New("parent issue not found")
+	}
+	if parent.ProjectID != child.ProjectID {
+		return nil, errors.New("parent issue belongs to another project")
+	}
+
+	ancestors, err := s.issueRepo.ListAncestors(ctx, parent.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range ancestors {
+		if a.ID == child.ID {
+			return nil, models.ErrIssueCycle
+		}
+	}
+
+	return parent, nil
+}
+
+// logParentChange records a re-parenting on the child and on both parents.
+func (s *IssueServiceImpl) logParentChange(ctx context.Context, child *models.Issue, oldParent *string, actorUserID string) {
+	_ = s.activity.Log(ctx, child.ID, &actorUserID, models.ActivityParentChanged, map[string]interface{}{
+		"old": oldParent,
+		"new": child.ParentIssueID,
+	})
+
+	if oldParent != nil {
+		_ = s.activity.Log(ctx, *oldParent, &actorUserID, models.ActivityChildRemoved, map[string]interface{}{
+			"issue_id": child.ID,
+			"title":    child.Title,
+		})
+	}
+	if child.ParentIssueID != nil {
+		_ = s.activity.Log(ctx, *child.ParentIssueID, &actorUserID, models.ActivityChildAdded, map[string]interface{}{
+			"issue_id": child.ID,
+			"title":    child.Title,
+		})
+	}
+}
+
+func (s *IssueServiceImpl) GetIssueTree(ctx context.Context, customerID, issueID string) (*models.IssueTree, error) {
+	i, err := s.ensureIssueAndTenant(ctx, customerID, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestors, err := s.issueRepo.ListAncestors(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	descendants, err := s.issueRepo.ListDescendants(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &models.IssueTreeNode{Issue: *i, Children: []*models.IssueTreeNode{}}
+	nodes := map[string]*models.IssueTreeNode{root.ID: root}
+	for _, d := range descendants {
+		nodes[d.ID] = &models.IssueTreeNode{Issue: d, Children: []*models.IssueTreeNode{}}
+	}
+	// Descendants come oldest first, so siblings keep creation order.
+	for _, d := range descendants {
+		if parent, ok := nodes[*d.ParentIssueID]; ok {
+			parent.Children = append(parent.Children, nodes[d.ID])
+		}
+	}
+
+	return &models.IssueTree{Ancestors: ancestors, Root: root}, nil
+}
+
+// GetIssueRollup aggregates the descendants of an issue. Story points are
+// taken from the highest estimated level only: a story's points already
+// cover its tasks, so those are not added again. Time tracking is summed
+// over the issue itself and every descendant.
+func (s *IssueServiceImpl) GetIssueRollup(ctx context.Context, customerID, issueID string) (*models.IssueRollup, error) {
+	root, err := s.ensureIssueAndTenant(ctx, customerID, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	descendants, err := s.issueRepo.ListDescendants(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &models.IssueRollup{
+		IssueID:  issueID,
+		ByStatus: map[string]int{},
+		ByType:   map[string]int{},
+	}
+
+	children := map[string][]models.Issue{}
+	for _, d := range descendants {
+		out.Total++
+		out.ByStatus[d.Status]++
+		out.ByType[d.IssueType]++
+		if models.IsIssueDone(d.Status) {
+			out.Done++
+		}
+		children[*d.ParentIssueID] = append(children[*d.ParentIssueID], d)
+	}
+
+	var addPoints func(parentID string)
+	addPoints = func(parentID string) {
+		for _, c := range children[parentID] {
+			if c.StoryPoints == nil {
+				addPoints(c.ID)
+				continue
+			}
+			out.StoryPoints += *c.StoryPoints
+			if models.IsIssueDone(c.Status) {
+				out.StoryPointsDone += *c.StoryPoints
+			}
+		}
+	}
+	addPoints(issueID)
+
+	switch {
+	case out.StoryPoints > 0:
+		out.Percent = out.StoryPointsDone * 100 / out.StoryPoints
+	case out.Total > 0:
+		out.Percent = out.Done * 100 / out.Total
+	}
+
+	all := append([]models.Issue{*root}, descendants...)
+	ids := make([]string, 0, len(all))
+	for _, i := range all {
+		ids = append(ids, i.ID)
+		if i.OriginalEstimate != nil {
+			out.Time.OriginalEstimate += *i.OriginalEstimate
+		}
+		if i.RemainingEstimate != nil {
+			out.Time.RemainingEstimate += *i.RemainingEstimate
+		}
+	}
+	spent, err := s.issueRepo.GetTimeSpent(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range spent {
+		out.Time.TimeSpent += m
+	}
+
+	return out, nil
+}
@@ -5,12 +5,14 @@ import (
 	"bugforge-backend/internal/models"
 	repo "bugforge-backend/internal/repository/interfaces"
 	service "bugforge-backend/internal/service/interfaces"
+	ws "bugforge-backend/internal/websocket"
 	websocket "bugforge-backend/internal/websocket/comments"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -21,33 +23,42 @@ import (
 type IssueServiceImpl struct {
 	issueRepo    repo.IssueRepository
 	projectRepo  repo.ProjectRepository
+	memberRepo   repo.ProjectMemberRepository
+	templateRepo repo.IssueTemplateRepository
 	userRepo     repo.UserRepository
 	commentRepo  repo.CommentRepository
 	activityRepo repo.ActivityRepository
 	activity     service.ActivityService
 	commentHub   *websocket.CommentHub
 	notifications service.NotificationService
+	board        boardNotifier
 }
 
 func NewIssueService(
 	issueRepo repo.IssueRepository,
 	projectRepo repo.ProjectRepository,
+	memberRepo repo.ProjectMemberRepository,
+	templateRepo repo.IssueTemplateRepository,
 	userRepo repo.UserRepository,
 	commentRepo repo.CommentRepository,
 	activityRepo repo.ActivityRepository,
 	activitySvc service.ActivityService,
 	commentHub *websocket.CommentHub,
 	notifSvc service.NotificationService,
+	boardHub *ws.Hub,
 ) service.IssueService {
 	return &IssueServiceImpl{
 		issueRepo:    issueRepo,
 		projectRepo:  projectRepo,
+		memberRepo:   memberRepo,
+		templateRepo: templateRepo,
 		userRepo:     userRepo,
 		commentRepo:  commentRepo,
 		activityRepo: activityRepo,
 		activity:     activitySvc,
 		commentHub:   commentHub,
 		notifications: notifSvc,
+		board:        boardNotifier{issueRepo: issueRepo, hub: boardHub},
 	}
 }
 
@@ -58,18 +69,29 @@ func NewIssueService(
 //
 
 var (
-	ErrInvalidStatus   = errors.New("invalid status")
-	ErrInvalidPriority = errors.New("invalid priority")
+	ErrInvalidStatus    = errors.New("invalid status")
+	ErrInvalidPriority  = errors.New("invalid priority")
+	ErrInvalidIssueType = errors.New("invalid issue type")
+	ErrInvalidSort      = errors.New("invalid sort field")
 )
 
 var validStatuses = map[string]bool{
 	"open": true, "in_progress": true, "resolved": true, "closed": true,
 }
 
+// initialIssueStatus is where the issue workflow starts: new issues and
+// clones are created in it.
+const initialIssueStatus = "open"
+
 var validPriorities = map[string]bool{
 	"low": true, "medium": true, "high": true, "critical": true,
 }
 
+var validIssueTypes = map[string]bool{
+	models.IssueTypeEpic: true, models.IssueTypeStory: true,
+	models.IssueTypeTask: true, models.IssueTypeBug: true,
+}
+
 //
 // ─────────────────────────────────────────────────────────────
 //   HELPERS
@@ -90,6 +112,15 @@ func (s *IssueServiceImpl) ensureIssueAndTenant(ctx context.Context, customerID,
 	return iss, nil
 }
 
+// issueConflict reports a lost update together with the issue as it is now.
+func (s *IssueServiceImpl) issueConflict(ctx context.Context, issueID string) error {
+	cur, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil || cur == nil {
+		return models.ErrVersionConflict
+	}
+	return &models.VersionConflictError{Version: cur.Version, Current: cur}
+}
+
 func (s *IssueServiceImpl) ensureProjectAndTenant(ctx context.Context, customerID, projectID string) error {
 	pr, err := s.projectRepo.GetByID(ctx, projectID, customerID)
 	if err != nil || pr == nil {
@@ -118,8 +149,10 @@ func (s *IssueServiceImpl) notify(userID, title, message string, metadata map[st
 
 func (s *IssueServiceImpl) CreateIssue(
 	ctx context.Context,
-	customerID, projectID, title, description, priority string,
+	customerID, projectID, title, description, priority, issueType string,
 	assignedTo *string,
+	parentIssueID *string,
+	templateID *string,
 	actorUserID string,
 ) (*models.Issue, error) {
 
@@ -132,15 +165,13 @@ func (s *IssueServiceImpl) CreateIssue(
 	}
 
 	if !validPriorities[priority] {
-		priority = "medium"
+		priority = ""
 	}
 
-	// Validate assignee
-	if assignedTo != nil {
-		u, err := s.userRepo.GetByID(ctx, *assignedTo)
-		if err != nil || u == nil || u.CustomerID != customerID {
-			return nil, errors.New("invalid assignee")
-		}
+	if issueType == "" {
+		issueType = models.IssueTypeTask
+	} else if !validIssueTypes[issueType] {
+		return nil, ErrInvalidIssueType
 	}
 
 	issue := &models.Issue{
@@ -148,22 +179,72 @@ func (s *IssueServiceImpl) CreateIssue(
 		ProjectID:   projectID,
 		Title:       title,
 		Description: description,
-		Status:      "open",
+		Status:      initialIssueStatus,
 		Priority:    priority,
+		IssueType:   issueType,
 		CreatedBy:   actorUserID,
 		AssignedTo:  assignedTo,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
 
-	if err := s.issueRepo.Create(ctx, issue); err != nil {
+	var parent *models.Issue
+	if parentIssueID != nil {
+		p, err := s.checkParent(ctx, customerID, issue, *parentIssueID)
+		if err != nil {
+			return nil, err
+		}
+		parent = p
+		issue.ParentIssueID = &p.ID
+	}
+
+	var tpl *models.IssueTemplate
+	if templateID != nil {
+		t, err := loadIssueTemplate(ctx, s.templateRepo, projectID, *templateID)
+		if err != nil {
+			return nil, err
+		}
+		tpl = t
+		applyIssueTemplate(issue, tpl)
+	}
+
+	if issue.Priority == "" {
+		issue.Priority = "medium"
+	}
+
+	// Validate assignee
+	if issue.AssignedTo != nil {
+		u, err := s.userRepo.GetByID(ctx, *issue.AssignedTo)
+		if err != nil || u == nil || u.CustomerID != customerID {
+			return nil, errors.New("invalid assignee")
+		}
+	}
+
+	if tpl != nil {
+		// Issues are created off the board, so there is no WIP warning.
+		if _, err := s.issueRepo.CreateFromTemplate(ctx, issue, tpl); err != nil {
+			return nil, err
+		}
+	} else if err := s.issueRepo.Create(ctx, issue); err != nil {
 		return nil, err
 	}
 
 	// Activity: issue created
-	_ = s.activity.Log(ctx, issue.ID, &actorUserID, models.ActivityCreated, map[string]interface{}{
+	meta := map[string]interface{}{
 		"title": issue.Title,
-	})
+	}
+	if tpl != nil {
+		meta["template_id"] = tpl.ID
+		meta["template"] = tpl.Name
+	}
+	_ = s.activity.Log(ctx, issue.ID, &actorUserID, models.ActivityCreated, meta)
+
+	if parent != nil {
+		_ = s.activity.Log(ctx, parent.ID, &actorUserID, models.ActivityChildAdded, map[string]interface{}{
+			"issue_id": issue.ID,
+			"title":    issue.Title,
+		})
+	}
 
 	// If assigned on create, log assignment as well
 	if issue.AssignedTo != nil {
@@ -176,12 +257,37 @@ func (s *IssueServiceImpl) CreateIssue(
 	return issue, nil
 }
 
-func (s *IssueServiceImpl) ListAllIssues(ctx context.Context, customerID string) ([]models.IssueWithUser, error) {
-	return s.issueRepo.ListAll(ctx, customerID)
+func (s *IssueServiceImpl) ListAllIssues(ctx context.Context, customerID string, page models.PageRequest) ([]models.IssueWithUser, models.PageInfo, error) {
+	issues, info, err := s.issueRepo.ListAll(ctx, customerID, page)
+	if err != nil {
+		return nil, info, err
+	}
+	if err := attachProgress(ctx, s.issueRepo, issues); err != nil {
+		return nil, info, err
+	}
+	return issues, info, nil
 }
 
 func (s *IssueServiceImpl) GetIssue(ctx context.Context, customerID, issueID string) (*models.Issue, error) {
-	return s.ensureIssueAndTenant(ctx, customerID, issueID)
+	i, err := s.ensureIssueAndTenant(ctx, customerID, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	byIssue, err := s.issueRepo.GetProgress(ctx, []string{issueID})
+	if err != nil {
+		return nil, err
+	}
+	i.Progress = byIssue[issueID]
+
+	spent, err := s.issueRepo.GetTimeSpent(ctx, []string{issueID})
+	if err != nil {
+		return nil, err
+	}
+	if m, ok := spent[issueID]; ok {
+		i.TimeSpent = &m
+	}
+	return i, nil
 }
 
 func (s *IssueServiceImpl) ListIssuesByProject(ctx context.Context, projectID, customerID string, q url.Values) ([]models.IssueWithUser, error) {
@@ -191,11 +297,15 @@ func (s *IssueServiceImpl) ListIssuesByProject(ctx context.Context, projectID, c
 	}
 
 	f := repo.IssueFilter{
-		SortBy:    "created_at",
-		Direction: "DESC",
-		Limit:     20,
-		Offset:    0,
+		Limit:  20,
+		Offset: 0,
+	}
+
+	sorts, err := parseIssueSort(q.Get("sort"), q.Get("direction"))
+	if err != nil {
+		return nil, err
 	}
+	f.Sort = sorts
 
 	if v := q.Get("status"); v != "" {
 		f.Status = &v
@@ -206,6 +316,23 @@ func (s *IssueServiceImpl) ListIssuesByProject(ctx context.Context, projectID, c
 	if v := q.Get("assigned_to"); v != "" {
 		f.AssignedTo = &v
 	}
+	if v := q.Get("issue_type"); v != "" {
+		if !validIssueTypes[v] {
+			return nil, ErrInvalidIssueType
+		}
+		f.IssueType = &v
+	}
+	if v := q.Get("epic_id"); v != "" {
+		f.EpicID = &v
+	}
+	if v := q.Get("fix_version_id"); v != "" {
+		f.FixVersionID = &v
+	}
+	if v := q.Get("sprint_id"); v == "backlog" {
+		f.Backlog = true
+	} else if v != "" {
+		f.SprintID = &v
+	}
 	if v := q.Get("search"); v != "" {
 		f.Search = &v
 	}
@@ -224,15 +351,90 @@ func (s *IssueServiceImpl) ListIssuesByProject(ctx context.Context, projectID, c
 		}
 	}
 
-	if v := q.Get("sort"); v != "" {
-		f.SortBy = v
+	issues, err := s.issueRepo.ListByProject(ctx, projectID, f)
+	if err != nil {
+		return nil, err
+	}
+	if err := attachProgress(ctx, s.issueRepo, issues); err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// parseIssueSort turns ?sort=priority:desc,-due_date,title into sort keys.
+// Each key is "field", "-field" (descending) or "field:asc|desc". The legacy
+// ?direction= only applies when a single key without its own direction is
+// given. Without ?sort= issues are listed newest first.
+func parseIssueSort(raw, direction string) ([]repo.IssueSort, error) {
+	if strings.TrimSpace(raw) == "" {
+		return []repo.IssueSort{{Field: "created_at", Desc: true}}, nil
 	}
 
-	if v := q.Get("direction"); v != "" {
-		f.Direction = strings.ToUpper(v)
+	keys := strings.Split(raw, ",")
+	out := make([]repo.IssueSort, 0, len(keys))
+
+	for _, k := range keys {
+		k = strings.TrimSpace(k)
+		if k == "" {
+			continue
+		}
+
+		var srt repo.IssueSort
+		explicit := false
+
+		if strings.HasPrefix(k, "-") {
+			k = k[1:]
+			srt.Desc = true
+			explicit = true
+		} else if field, dir, ok := strings.Cut(k, ":"); ok {
+			switch strings.ToLower(dir) {
+			case "asc":
+			case "desc":
+				srt.Desc = true
+			default:
+				return nil, fmt.Errorf("%w: %s", ErrInvalidSort, k)
+			}
+			k = field
+			explicit = true
+		}
+
+		if !validSortField(k) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSort, k)
+		}
+		srt.Field = k
+
+		if !explicit && len(keys) == 1 {
+			switch strings.ToUpper(direction) {
+			case "", "ASC":
+			case "DESC":
+				srt.Desc = true
+			default:
+				return nil, errors.New("invalid sort direction")
+			}
+		}
+
+		out = append(out, srt)
 	}
 
-	return s.issueRepo.ListByProject(ctx, projectID, f)
+	if len(out) == 0 {
+		return nil, ErrInvalidSort
+	}
+	return out, nil
+}
+
+func validSortField(field string) bool {
+	if key, ok := strings.CutPrefix(field, repo.CustomFieldSortPrefix); ok {
+		if key == "" || len(key) > 64 {
+			return false
+		}
+		for _, r := range key {
+			if !(r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+				return false
+			}
+		}
+		return true
+	}
+	return repo.SortableIssueFields[field]
 }
 
 //
@@ -244,8 +446,8 @@ func (s *IssueServiceImpl) ListIssuesByProject(ctx context.Context, projectID, c
 func (s *IssueServiceImpl) UpdateIssue(
 	ctx context.Context,
 	customerID, issueID string,
-	title, description, status, priority string,
-	assignedTo *string,
+	patch models.IssuePatch,
+	expectedVersion *int,
 	actorUserID string,
 ) (*models.Issue, error) {
 
@@ -254,56 +456,138 @@ func (s *IssueServiceImpl) UpdateIssue(
 		return nil, err
 	}
 
+	if expectedVersion != nil && *expectedVersion != i.Version {
+		return nil, &models.VersionConflictError{Version: i.Version, Current: i}
+	}
+
 	// Keep old values for diffing
 	oldStatus := i.Status
 	oldAssigned := i.AssignedTo
 	oldTitle := i.Title
 	oldDescription := i.Description
 	oldPriority := i.Priority
-
-	// Apply updates only if provided
-	if title != "" {
-		i.Title = title
+	oldDueDate := i.DueDate
+	oldCustomFields := i.CustomFields
+	oldType := i.IssueType
+	oldParent := i.ParentIssueID
+	oldPoints := i.StoryPoints
+	oldOriginal := i.OriginalEstimate
+	oldRemaining := i.RemainingEstimate
+
+	// Apply the merge patch: absent = unchanged, null = clear
+	if patch.Title.Set {
+		if patch.Title.Null || strings.TrimSpace(patch.Title.Value) == "" {
+			return nil, errors.New("title cannot be empty")
+		}
+		i.Title = patch.Title.Value
 	}
-	if description != "" {
-		i.Description = description
+	if patch.Description.Set {
+		i.Description = patch.Description.Value // "" when null
 	}
-	if status != "" {
-		if !validStatuses[status] {
+	if patch.Status.Set {
+		if patch.Status.Null || !validStatuses[patch.Status.Value] {
 			return nil, ErrInvalidStatus
 		}
-		i.Status = status
+		i.Status = patch.Status.Value
 	}
-	if priority != "" {
-		if !validPriorities[priority] {
+	if patch.Priority.Set {
+		if patch.Priority.Null || !validPriorities[patch.Priority.Value] {
 			return nil, ErrInvalidPriority
 		}
-		i.Priority = priority
+		i.Priority = patch.Priority.Value
+	}
+	if patch.AssignedTo.Set {
+		if patch.AssignedTo.Null {
+			i.AssignedTo = nil
+		} else {
+			u, err := s.userRepo.GetByID(ctx, patch.AssignedTo.Value)
+			if err != nil || u == nil || u.CustomerID != customerID {
+				return nil, errors.New("invalid assignee")
+			}
+			assignee := patch.AssignedTo.Value
+			i.AssignedTo = &assignee
+		}
 	}
-
-	if assignedTo != nil {
-		u, err := s.userRepo.GetByID(ctx, *assignedTo)
-		if err != nil || u == nil || u.CustomerID != customerID {
-			return nil, errors.New("invalid assignee")
+	if patch.DueDate.Set {
+		if patch.DueDate.Null {
+			i.DueDate = nil
+		} else {
+			due := patch.DueDate.Value
+			i.DueDate = &due
+		}
+	}
+	if patch.CustomFields.Set {
+		if patch.CustomFields.Null {
+			i.CustomFields = map[string]interface{}{}
+		} else {
+			i.CustomFields = models.MergePatch(i.CustomFields, patch.CustomFields.Value)
+		}
+	}
+	if patch.IssueType.Set {
+		if patch.IssueType.Null || !validIssueTypes[patch.IssueType.Value] {
+			return nil, ErrInvalidIssueType
+		}
+		i.IssueType = patch.IssueType.Value
+	}
+	if patch.StoryPoints.Set {
+		if patch.StoryPoints.Null {
+			i.StoryPoints = nil
+		} else if patch.StoryPoints.Value < 0 {
+			return nil, errors.New("story points cannot be negative")
+		} else {
+			points := patch.StoryPoints.Value
+			i.StoryPoints = &points
+		}
+	}
+	if patch.OriginalEstimate.Set {
+		v, err := estimateFromPatch(patch.OriginalEstimate)
+		if err != nil {
+			return nil, err
+		}
+		i.OriginalEstimate = v
+		// A first estimate also seeds the remaining one
+		if !patch.RemainingEstimate.Set && oldOriginal == nil && i.RemainingEstimate == nil {
+			i.RemainingEstimate = v
 		}
-		i.AssignedTo = assignedTo
+	}
+	if patch.RemainingEstimate.Set {
+		v, err := estimateFromPatch(patch.RemainingEstimate)
+		if err != nil {
+			return nil, err
+		}
+		i.RemainingEstimate = v
+	}
+	if patch.ParentIssueID.Set {
+		if patch.ParentIssueID.Null {
+			i.ParentIssueID = nil
+		} else {
+			p, err := s.checkParent(ctx, customerID, i, patch.ParentIssueID.Value)
+			if err != nil {
+				return nil, err
+			}
+			i.ParentIssueID = &p.ID
+		}
+	} else if i.IssueType == models.IssueTypeEpic && i.ParentIssueID != nil {
+		return nil, errEpicWithParent
 	}
 
 	i.UpdatedAt = time.Now()
 
-	if err := s.issueRepo.Update(ctx, i); err != nil {
+	if err := s.issueRepo.Update(ctx, i, expectedVersion); err != nil {
+		if errors.Is(err, models.ErrVersionConflict) {
+			return nil, s.issueConflict(ctx, issueID)
+		}
 		return nil, err
 	}
 
 	// Activity logs (extended)
 	// Status changed
-	if status != "" && status != oldStatus {
+	if i.Status != oldStatus {
 		_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityStatusChanged, map[string]interface{}{
 			"old": oldStatus,
-			"new": status,
+			"new": i.Status,
 		})
 
-		
 		// Notify assigned user if exists
 		if i.AssignedTo != nil {
 			s.notify(*i.AssignedTo,
@@ -320,8 +604,9 @@ func (s *IssueServiceImpl) UpdateIssue(
 	}
 
 	// Assignment changed
-	if (oldAssigned == nil && i.AssignedTo != nil) ||
-		(oldAssigned != nil && i.AssignedTo == nil) ||
+	if oldAssigned != nil && i.AssignedTo == nil {
+		s.logCleared(ctx, issueID, actorUserID, "assigned_to", oldAssigned)
+	} else if (oldAssigned == nil && i.AssignedTo != nil) ||
 		(oldAssigned != nil && i.AssignedTo != nil && *oldAssigned != *i.AssignedTo) {
 
 		_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityAssigned, map[string]interface{}{
@@ -329,31 +614,25 @@ func (s *IssueServiceImpl) UpdateIssue(
 			"new": i.AssignedTo,
 		})
 
-		fmt.Println(i.AssignedTo);
-
-		if i.AssignedTo != nil {
-			s.notify(*i.AssignedTo,
-				"New Assignment",
-				fmt.Sprintf("You were assigned to issue %s", i.Title),
-				map[string]interface{}{
-					"issue_id": issueID,
-					"field":    "assigned_to",
-					"old":      oldAssigned,
-					"new":      i.AssignedTo,
-				},
-			)
-		}
-
+		s.notify(*i.AssignedTo,
+			"New Assignment",
+			fmt.Sprintf("You were assigned to issue %s", i.Title),
+			map[string]interface{}{
+				"issue_id": issueID,
+				"field":    "assigned_to",
+				"old":      oldAssigned,
+				"new":      i.AssignedTo,
+			},
+		)
 	}
 
 	// Title changed
-	if title != "" && title != oldTitle {
+	if i.Title != oldTitle {
 		_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityTitleUpdated, map[string]interface{}{
 			"old": oldTitle,
-			"new": title,
+			"new": i.Title,
 		})
 
-		fmt.Println(i.AssignedTo);
 		if i.AssignedTo != nil {
 			s.notify(*i.AssignedTo,
 				"Issue Title Updated",
@@ -369,24 +648,25 @@ func (s *IssueServiceImpl) UpdateIssue(
 	}
 
 	// Description changed
-	if description != "" && description != oldDescription {
-		_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityDescriptionUpdated, map[string]interface{}{
-			"old": oldDescription,
-			"new": description,
-		})
+	if i.Description != oldDescription {
+		if i.Description == "" {
+			s.logCleared(ctx, issueID, actorUserID, "description", oldDescription)
+		} else {
+			_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityDescriptionUpdated, map[string]interface{}{
+				"old": oldDescription,
+				"new": i.Description,
+			})
+		}
 	}
 
 	// Priority changed
-	if priority != "" && priority != oldPriority {
+	if i.Priority != oldPriority {
 		_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityPriorityChanged, map[string]interface{}{
 			"old": oldPriority,
-			"new": priority,
+			"new": i.Priority,
 		})
 
-		fmt.Println("Priority Changed ", i.AssignedTo);
-
 		if i.AssignedTo != nil {
-			fmt.Println("Priority Changed ", i.AssignedTo);
 			s.notify(*i.AssignedTo,
 				"Priority Updated",
 				fmt.Sprintf("Priority updated to %s", i.Priority),
@@ -400,9 +680,95 @@ func (s *IssueServiceImpl) UpdateIssue(
 		}
 	}
 
+	// Due date changed
+	if oldDueDate != nil && i.DueDate == nil {
+		s.logCleared(ctx, issueID, actorUserID, "due_date", oldDueDate)
+	} else if i.DueDate != nil && (oldDueDate == nil || !oldDueDate.Equal(*i.DueDate)) {
+		_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityDueDateUpdated, map[string]interface{}{
+			"due_date": i.DueDate,
+		})
+	}
+
+	// Custom fields: one entry per removed key, one for the rest
+	if patch.CustomFields.Set {
+		changed := map[string]interface{}{}
+		for k, old := range oldCustomFields {
+			if _, ok := i.CustomFields[k]; !ok {
+				s.logCleared(ctx, issueID, actorUserID, "custom_fields."+k, old)
+			}
+		}
+		for k, v := range i.CustomFields {
+			if old, ok := oldCustomFields[k]; !ok || !reflect.DeepEqual(old, v) {
+				changed[k] = v
+			}
+		}
+		if len(changed) > 0 {
+			_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityCustomFieldsUpdated, map[string]interface{}{
+				"changed": changed,
+			})
+		}
+	}
+
+	// Hierarchy
+	if i.IssueType != oldType {
+		_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityTypeChanged, map[string]interface{}{
+			"old": oldType,
+			"new": i.IssueType,
+		})
+	}
+	if oldPoints != nil && i.StoryPoints == nil {
+		s.logCleared(ctx, issueID, actorUserID, "story_points", *oldPoints)
+	} else if i.StoryPoints != nil && (oldPoints == nil || *oldPoints != *i.StoryPoints) {
+		_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityStoryPointsChanged, map[string]interface{}{
+			"old": oldPoints,
+			"new": *i.StoryPoints,
+		})
+	}
+	if !models.SameID(oldParent, i.ParentIssueID) {
+		s.logParentChange(ctx, i, oldParent, actorUserID)
+	}
+
+	// Time tracking
+	s.logEstimateChange(ctx, issueID, actorUserID, "original_estimate_minutes", oldOriginal, i.OriginalEstimate)
+	s.logEstimateChange(ctx, issueID, actorUserID, "remaining_estimate_minutes", oldRemaining, i.RemainingEstimate)
+
 	return i, nil
 }
 
+// logEstimateChange records a changed estimate; a removed one is logged as
+// a cleared field like any other.
+func (s *IssueServiceImpl) logEstimateChange(ctx context.Context, issueID, actorUserID, field string, old, cur *int) {
+	switch {
+	case old != nil && cur == nil:
+		s.logCleared(ctx, issueID, actorUserID, field, *old)
+	case cur != nil && (old == nil || *old != *cur):
+		_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityEstimateChanged, map[string]interface{}{
+			"field": field,
+			"old":   old,
+			"new":   *cur,
+		})
+	}
+}
+
+// estimateFromPatch validates an estimate in minutes; null clears it.
+func estimateFromPatch(f models.PatchField[int]) (*int, error) {
+	if f.Null {
+		return nil, nil
+	}
+	if f.Value < 0 {
+		return nil, errors.New("estimates cannot be negative")
+	}
+	v := f.Value
+	return &v, nil
+}
+
+func (s *IssueServiceImpl) logCleared(ctx context.Context, issueID, actorUserID, field string, old interface{}) {
+	_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityFieldCleared, map[string]interface{}{
+		"field": field,
+		"old":   old,
+	})
+}
+
 //
 // ─────────────────────────────────────────────────────────────
 //   DELETE ISSUE
@@ -416,7 +782,7 @@ func (s *IssueServiceImpl) DeleteIssue(ctx context.Context, customerID, issueID,
 		return err
 	}
 
-	if err := s.issueRepo.Delete(ctx, issueID); err != nil {
+	if err := s.issueRepo.Delete(ctx, issueID, actorUserID); err != nil {
 		return err
 	}
 
@@ -427,6 +793,228 @@ func (s *IssueServiceImpl) DeleteIssue(ctx context.Context, customerID, issueID,
 	return nil
 }
 
+//
+// ─────────────────────────────────────────────────────────────
+//   MOVE / CLONE ACROSS PROJECTS
+// ─────────────────────────────────────────────────────────────
+//
+
+func (s *IssueServiceImpl) MoveIssue(
+	ctx context.Context,
+	customerID, issueID string,
+	req models.MoveIssueRequest,
+	actorUserID string,
+) (*models.MoveIssueResult, error) {
+
+	i, err := s.ensureIssueAndTenant(ctx, customerID, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TargetProjectID == "" {
+		return nil, errors.New("target_project_id is required")
+	}
+	if err := s.ensureProjectAndTenant(ctx, customerID, req.TargetProjectID); err != nil {
+		return nil, err
+	}
+
+	// Statuses are shared by every project, so only the assignee has to be
+	// checked against the target.
+	clearAssignee := false
+	if i.AssignedTo != nil {
+		ok, err := s.memberRepo.IsMember(ctx, req.TargetProjectID, *i.AssignedTo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			if !req.UnassignNonMember {
+				return nil, errors.New("assignee is not a member of the target project")
+			}
+			clearAssignee = true
+		}
+	}
+
+	res, err := s.issueRepo.MoveToProject(ctx, issueID, req.TargetProjectID, req.ColumnID, clearAssignee)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Issue, err = s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityMovedToProject, map[string]interface{}{
+		"from_project_id": res.FromProjectID,
+		"to_project_id":   req.TargetProjectID,
+		"column_id":       res.ColumnID,
+		"unassigned":      res.Unassigned,
+		"labels_dropped":  res.LabelsDropped,
+	})
+
+	if clearAssignee {
+		s.logCleared(ctx, issueID, actorUserID, "assigned_to", i.AssignedTo)
+	}
+
+	// The card leaves the source boards and shows up on the target ones.
+	if res.FromColumnID != nil {
+		s.board.send(res.FromProjectID, map[string]any{
+			"type":       "card_deleted",
+			"projectID":  res.FromProjectID,
+			"payload": map[string]any{
+				"card_id":   issueID,
+				"column_id": *res.FromColumnID,
+			},
+		})
+	}
+	if res.ColumnID != nil && res.Issue != nil {
+		card := *res.Issue
+		card.ColumnID = *res.ColumnID
+		s.board.send(req.TargetProjectID, map[string]any{
+			"type":      "card_created",
+			"projectID": req.TargetProjectID,
+			"card":      &card,
+		})
+		s.board.wipWarning(req.TargetProjectID, issueID, res.WIPWarning)
+	}
+
+	return res, nil
+}
+
+func (s *IssueServiceImpl) CloneIssue(
+	ctx context.Context,
+	customerID, issueID string,
+	req models.CloneIssueRequest,
+	actorUserID string,
+) (*models.Issue, error) {
+
+	src, err := s.ensureIssueAndTenant(ctx, customerID, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := &models.Issue{
+		ID:           uuid.NewString(),
+		ProjectID:    src.ProjectID,
+		Title:        src.Title,
+		Description:  src.Description,
+		Status:       initialIssueStatus,
+		Priority:     src.Priority,
+		IssueType:    src.IssueType,
+		ParentIssueID: src.ParentIssueID,
+		StoryPoints:  src.StoryPoints,
+		CreatedBy:    actorUserID,
+		AssignedTo:   src.AssignedTo,
+		DueDate:      src.DueDate,
+		CustomFields: src.CustomFields,
+	}
+
+	if req.Title != nil {
+		if strings.TrimSpace(*req.Title) == "" {
+			return nil, errors.New("title cannot be empty")
+		}
+		clone.Title = *req.Title
+	}
+
+	if req.TargetProjectID != nil && *req.TargetProjectID != src.ProjectID {
+		if err := s.ensureProjectAndTenant(ctx, customerID, *req.TargetProjectID); err != nil {
+			return nil, err
+		}
+		clone.ProjectID = *req.TargetProjectID
+		clone.ParentIssueID = nil
+
+		// A copy in another project keeps the assignee only if they can
+		// see it there.
+		if clone.AssignedTo != nil {
+			ok, err := s.memberRepo.IsMember(ctx, clone.ProjectID, *clone.AssignedTo)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				clone.AssignedTo = nil
+			}
+		}
+	}
+
+	warning, err := s.issueRepo.Clone(ctx, src.ID, clone, req)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = s.activity.Log(ctx, src.ID, &actorUserID, models.ActivityCloned, map[string]interface{}{
+		"clone_id":   clone.ID,
+		"project_id": clone.ProjectID,
+	})
+	_ = s.activity.Log(ctx, clone.ID, &actorUserID, models.ActivityClonedFrom, map[string]interface{}{
+		"source_id":         src.ID,
+		"source_project_id": src.ProjectID,
+		"subtasks":          req.Subtasks,
+		"checklists":        req.Checklists,
+		"attachments":       req.Attachments,
+		"relations":         req.Relations,
+	})
+
+	if clone.ColumnID != "" {
+		s.board.send(clone.ProjectID, map[string]any{
+			"type":      "card_created",
+			"projectID": clone.ProjectID,
+			"card":      clone,
+		})
+		s.board.wipWarning(clone.ProjectID, clone.ID, warning)
+	}
+
+	if clone.AssignedTo != nil && *clone.AssignedTo != actorUserID {
+		s.notify(*clone.AssignedTo,
+			"New Assignment",
+			fmt.Sprintf("You were assigned to issue %s", clone.Title),
+			map[string]interface{}{
+				"issue_id":  clone.ID,
+				"source_id": src.ID,
+			},
+		)
+	}
+
+	return clone, nil
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   TRASH
+// ─────────────────────────────────────────────────────────────
+//
+
+func (s *IssueServiceImpl) ListTrash(ctx context.Context, customerID, projectID string) ([]models.TrashItem, error) {
+	if err := s.ensureProjectAndTenant(ctx, customerID, projectID); err != nil {
+		return nil, err
+	}
+	return s.issueRepo.ListTrash(ctx, projectID)
+}
+
+func (s *IssueServiceImpl) RestoreIssue(ctx context.Context, customerID, issueID, actorUserID string) (*models.Issue, error) {
+	i, err := s.issueRepo.GetDeletedByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if i == nil {
+		return nil, models.ErrNotInTrash
+	}
+
+	// A trashed project has to be restored as a whole.
+	if err := s.ensureProjectAndTenant(ctx, customerID, i.ProjectID); err != nil {
+		return nil, err
+	}
+
+	if err := s.issueRepo.Restore(ctx, issueID); err != nil {
+		return nil, err
+	}
+
+	_ = s.activity.Log(ctx, issueID, &actorUserID, models.ActivityRestored, map[string]interface{}{
+		"title": i.Title,
+	})
+
+	return s.issueRepo.GetByID(ctx, issueID)
+}
+
 //
 // ─────────────────────────────────────────────────────────────
 //   DUE DATE
@@ -619,6 +1207,7 @@ func (s *IssueServiceImpl) CreateComment(
 func (s *IssueServiceImpl) UpdateComment(
 	ctx context.Context,
 	customerID, commentID, userID, body string,
+	expectedVersion *int,
 ) (*models.IssueComment, error) {
 
 	if body == "" {
@@ -641,6 +1230,10 @@ func (s *IssueServiceImpl) UpdateComment(
 		return nil, errors.New("cannot edit others' comments")
 	}
 
+	if expectedVersion != nil && *expectedVersion != c.Version {
+		return nil, &models.VersionConflictError{Version: c.Version, Current: c}
+	}
+
 	// Keep old body for diffing
 	oldBody := c.Body
 
@@ -652,7 +1245,12 @@ func (s *IssueServiceImpl) UpdateComment(
 	c.UpdatedAt = &now
 
 	// Save DB
-	if err := s.issueRepo.UpdateComment(ctx, c); err != nil {
+	if err := s.issueRepo.UpdateComment(ctx, c, expectedVersion); err != nil {
+		if errors.Is(err, models.ErrVersionConflict) {
+			if cur, gerr := s.issueRepo.GetCommentByID(ctx, commentID); gerr == nil && cur != nil {
+				return nil, &models.VersionConflictError{Version: cur.Version, Current: cur}
+			}
+		}
 		return nil, err
 	}
 
@@ -743,19 +1341,68 @@ func (s *IssueServiceImpl) DeleteComment(
 		"comment_id": commentID,
 	})
 
-	return s.issueRepo.DeleteComment(ctx, commentID)
+	return s.issueRepo.DeleteComment(ctx, commentID, userID)
+}
+
+func (s *IssueServiceImpl) RestoreComment(
+	ctx context.Context,
+	customerID, commentID, userID string,
+) (*models.IssueComment, error) {
+
+	c, err := s.issueRepo.GetDeletedCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, models.ErrNotInTrash
+	}
+
+	// Ownership check
+	if c.UserID != userID {
+		return nil, errors.New("cannot restore others' comments")
+	}
+
+	// Tenant validation; comments of a trashed issue come back with the issue
+	if _, err := s.ensureIssueAndTenant(ctx, customerID, c.IssueID); err != nil {
+		return nil, err
+	}
+
+	if err := s.issueRepo.RestoreComment(ctx, commentID); err != nil {
+		return nil, err
+	}
+
+	restored, err := s.issueRepo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	evt := websocket.CommentEvent{
+		Type:    "comment_restored",
+		IssueID: c.IssueID,
+		ActorID: userID,
+		Payload: restored,
+	}
+	b, _ := json.Marshal(evt)
+	s.commentHub.GetRoom(c.IssueID).Broadcast(b)
+
+	_ = s.activity.Log(ctx, c.IssueID, &userID, models.ActivityCommentRestored, map[string]interface{}{
+		"comment_id": commentID,
+	})
+
+	return restored, nil
 }
 
 func (s *IssueServiceImpl) ListComments(
 	ctx context.Context,
 	customerID, issueID string,
-) ([]models.IssueComment, error) {
+	page models.PageRequest,
+) ([]models.IssueComment, models.PageInfo, error) {
 
 	if _, err := s.ensureIssueAndTenant(ctx, customerID, issueID); err != nil {
-		return nil, err
+		return nil, models.PageInfo{}, err
 	}
 
-	return s.issueRepo.ListCommentsByIssue(ctx, issueID)
+	return s.issueRepo.ListCommentsByIssue(ctx, issueID, page)
 }
 
 //
@@ -857,21 +1504,39 @@ func (s *IssueServiceImpl) CreateChecklistItem(ctx context.Context, customerID,
 		"content": item.Content,
 	})
 
+	s.board.checklistChanged(ctx, item.ChecklistID)
+
 	return item, nil
 }
 
-func (s *IssueServiceImpl) UpdateChecklistItem(ctx context.Context, customerID, itemID string, content string, done bool, userID string) (*models.ChecklistItem, error) {
+func (s *IssueServiceImpl) UpdateChecklistItem(ctx context.Context, customerID, itemID string, content string, done bool, expectedVersion *int, userID string) (*models.ChecklistItem, error) {
 
-	// Repo missing GetChecklistItem — skipping tenant check. If available, fetch it first for old values.
-	item := &models.ChecklistItem{
-		ID:         itemID,
-		Content:    content,
-		Done:       done,
-		OrderIndex: 0, // not updated
+	item, err := s.issueRepo.GetChecklistItemByID(ctx, itemID)
+	if err != nil || item == nil {
+		return nil, errors.New("checklist item not found")
 	}
 
-	err := s.issueRepo.UpdateChecklistItem(ctx, item)
-	if err != nil {
+	cl, err := s.issueRepo.GetChecklistByID(ctx, item.ChecklistID)
+	if err != nil || cl == nil {
+		return nil, errors.New("checklist not found")
+	}
+	if _, err := s.ensureIssueAndTenant(ctx, customerID, cl.IssueID); err != nil {
+		return nil, err
+	}
+
+	if expectedVersion != nil && *expectedVersion != item.Version {
+		return nil, &models.VersionConflictError{Version: item.Version, Current: item}
+	}
+
+	item.Content = content
+	item.Done = done
+
+	if err := s.issueRepo.UpdateChecklistItem(ctx, item, expectedVersion); err != nil {
+		if errors.Is(err, models.ErrVersionConflict) {
+			if cur, gerr := s.issueRepo.GetChecklistItemByID(ctx, itemID); gerr == nil && cur != nil {
+				return nil, &models.VersionConflictError{Version: cur.Version, Current: cur}
+			}
+		}
 		return nil, err
 	}
 
@@ -881,11 +1546,14 @@ func (s *IssueServiceImpl) UpdateChecklistItem(ctx context.Context, customerID,
 		"done":    item.Done,
 	})
 
+	s.board.issueChanged(ctx, cl.IssueID)
+
 	return item, nil
 }
 
 func (s *IssueServiceImpl) DeleteChecklist(ctx context.Context, customerID, checklistID string) error {
 	// TODO: ideally validate checklist belongs to issue & tenant
+	cl, _ := s.issueRepo.GetChecklistByID(ctx, checklistID)
 
 	if err := s.issueRepo.DeleteChecklist(ctx, checklistID); err != nil {
 		return err
@@ -895,15 +1563,28 @@ func (s *IssueServiceImpl) DeleteChecklist(ctx context.Context, customerID, chec
 		"checklist_id": checklistID,
 	})
 
+	if cl != nil {
+		s.board.issueChanged(ctx, cl.IssueID)
+	}
+
 	return nil
 }
 
 func (s *IssueServiceImpl) DeleteChecklistItem(ctx context.Context, customerID, itemID string) error {
 	// Optionally load checklistID for metadata
+	item, _ := s.issueRepo.GetChecklistItemByID(ctx, itemID)
+
 	_ = s.activity.Log(ctx, itemID, nil, models.ActivityChecklistItemDeleted, map[string]interface{}{
 		"item_id": itemID,
 	})
-	return s.issueRepo.DeleteChecklistItem(ctx, itemID)
+	if err := s.issueRepo.DeleteChecklistItem(ctx, itemID); err != nil {
+		return err
+	}
+
+	if item != nil {
+		s.board.checklistChanged(ctx, item.ChecklistID)
+	}
+	return nil
 }
 
 func (s *IssueServiceImpl) ReorderChecklistItems(
@@ -963,6 +1644,8 @@ func (s *IssueServiceImpl) CreateSubtask(
 		"title":      sub.Title,
 	})
 
+	s.board.issueChanged(ctx, issueID)
+
 	return sub, nil
 }
 
@@ -1038,6 +1721,8 @@ func (s *IssueServiceImpl) UpdateSubtask(
 			"new":        status,
 		})
 
+		s.board.issueChanged(ctx, updated.ParentIssueID)
+
 	}
 	if (oldAssigned == nil && existing.AssignedTo != nil) ||
 		(oldAssigned != nil && existing.AssignedTo == nil) ||
@@ -1078,10 +1763,194 @@ func (s *IssueServiceImpl) DeleteSubtask(ctx context.Context, customerID, subtas
 		_ = s.activity.Log(ctx, sub.ParentIssueID, nil, models.ActivitySubtaskDeleted, map[string]interface{}{
 			"subtask_id": subtaskID,
 		})
+		s.board.issueChanged(ctx, sub.ParentIssueID)
 	}
 	return nil
 }
 
+//
+// ─────────────────────────────────────────────────────────────
+//   SUBTASK <-> ISSUE CONVERSION
+// ─────────────────────────────────────────────────────────────
+//
+
+// PromoteSubtask turns a subtask of parentIssueID into an issue of the
+// parent's project. The former parent stays linked through subtask_of /
+// parent_of relations.
+func (s *IssueServiceImpl) PromoteSubtask(
+	ctx context.Context,
+	customerID, parentIssueID, subtaskID string,
+	req models.PromoteSubtaskRequest,
+	userID string,
+) (*models.Issue, error) {
+
+	st, err := s.issueRepo.GetSubtaskByID(ctx, subtaskID)
+	if err != nil || st == nil || st.ParentIssueID != parentIssueID {
+		return nil, errors.New("subtask not found")
+	}
+
+	parent, err := s.ensureIssueAndTenant(ctx, customerID, st.ParentIssueID)
+	if err != nil {
+		return nil, err
+	}
+
+	priority := parent.Priority
+	if req.Priority != "" {
+		if !validPriorities[req.Priority] {
+			return nil, ErrInvalidPriority
+		}
+		priority = req.Priority
+	}
+
+	description := ""
+	if st.Description != nil {
+		description = *st.Description
+	}
+
+	issue := &models.Issue{
+		ID:          uuid.NewString(),
+		ProjectID:   parent.ProjectID,
+		Title:       st.Title,
+		Description: description,
+		Status:      issueStatusFromSubtask(st.Status),
+		Priority:    priority,
+		CreatedBy:   userID,
+		AssignedTo:  st.AssignedTo,
+		DueDate:     st.DueDate,
+	}
+
+	warning, err := s.issueRepo.PromoteSubtask(ctx, subtaskID, issue, req.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = s.activity.Log(ctx, parent.ID, &userID, models.ActivitySubtaskPromoted, map[string]interface{}{
+		"subtask_id": subtaskID,
+		"issue_id":   issue.ID,
+		"title":      issue.Title,
+	})
+	_ = s.activity.Log(ctx, issue.ID, &userID, models.ActivityPromotedFromSubtask, map[string]interface{}{
+		"subtask_id": subtaskID,
+		"parent_id":  parent.ID,
+	})
+
+	if issue.ColumnID != "" {
+		s.board.send(issue.ProjectID, map[string]any{
+			"type":      "card_created",
+			"projectID": issue.ProjectID,
+			"card":      issue,
+		})
+		s.board.wipWarning(issue.ProjectID, issue.ID, warning)
+	}
+	s.board.issueChanged(ctx, parent.ID)
+
+	return issue, nil
+}
+
+// DemoteIssue turns an issue into a subtask of another issue of the same
+// project. The issue itself goes to the trash with its comments.
+func (s *IssueServiceImpl) DemoteIssue(
+	ctx context.Context,
+	customerID, issueID string,
+	req models.DemoteIssueRequest,
+	userID string,
+) (*models.Subtask, error) {
+
+	i, err := s.ensureIssueAndTenant(ctx, customerID, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ParentIssueID == "" {
+		return nil, errors.New("parent_issue_id is required")
+	}
+	if req.ParentIssueID == issueID {
+		return nil, errors.New("an issue cannot become its own subtask")
+	}
+
+	parent, err := s.ensureIssueAndTenant(ctx, customerID, req.ParentIssueID)
+	if err != nil {
+		return nil, err
+	}
+	if parent.ProjectID != i.ProjectID {
+		return nil, errors.New("parent issue belongs to another project")
+	}
+
+	children, err := s.issueRepo.ListDescendants(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if len(children) > 0 {
+		return nil, errors.New("issue has child issues; move them first")
+	}
+
+	var description *string
+	if i.Description != "" {
+		description = &i.Description
+	}
+
+	sub := &models.Subtask{
+		ID:            uuid.NewString(),
+		ParentIssueID: parent.ID,
+		Title:         i.Title,
+		Description:   description,
+		Status:        subtaskStatusFromIssue(i.Status),
+		AssignedTo:    i.AssignedTo,
+		DueDate:       i.DueDate,
+	}
+
+	if err := s.issueRepo.DemoteToSubtask(ctx, issueID, sub, userID); err != nil {
+		return nil, err
+	}
+
+	_ = s.activity.Log(ctx, issueID, &userID, models.ActivityDemotedToSubtask, map[string]interface{}{
+		"parent_id":  parent.ID,
+		"subtask_id": sub.ID,
+	})
+	_ = s.activity.Log(ctx, parent.ID, &userID, models.ActivityIssueDemoted, map[string]interface{}{
+		"issue_id":   issueID,
+		"subtask_id": sub.ID,
+		"title":      sub.Title,
+	})
+
+	s.board.send(i.ProjectID, map[string]any{
+		"type":       "card_deleted",
+		"projectID":  i.ProjectID,
+		"payload": map[string]any{
+			"card_id":   issueID,
+			"column_id": i.ColumnID,
+		},
+	})
+	s.board.issueChanged(ctx, parent.ID)
+
+	return sub, nil
+}
+
+// issueStatusFromSubtask maps the free-form subtask status onto the issue
+// workflow.
+func issueStatusFromSubtask(status string) string {
+	for _, done := range models.SubtaskDoneStatuses {
+		if status == done {
+			return "resolved"
+		}
+	}
+	if validStatuses[status] {
+		return status
+	}
+	return initialIssueStatus
+}
+
+func subtaskStatusFromIssue(status string) string {
+	switch status {
+	case "resolved", "closed":
+		return "done"
+	case "in_progress":
+		return "in_progress"
+	default:
+		return "open"
+	}
+}
+
 //
 // ─────────────────────────────────────────────────────────────
 //   ACTIVITY
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	service "bugforge-backend/internal/service/interfaces"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type IssueTemplateServiceImpl struct {
+	templateRepo repo.IssueTemplateRepository
+	projectRepo  repo.ProjectRepository
+	userRepo     repo.UserRepository
+	labelRepo    repo.LabelRepository
+}
+
+func NewIssueTemplateService(
+	templateRepo repo.IssueTemplateRepository,
+	projectRepo repo.ProjectRepository,
+	userRepo repo.UserRepository,
+	labelRepo repo.LabelRepository,
+) service.IssueTemplateService {
+	return &IssueTemplateServiceImpl{
+		templateRepo: templateRepo,
+		projectRepo:  projectRepo,
+		userRepo:     userRepo,
+		labelRepo:    labelRepo,
+	}
+}
+
+func (s *IssueTemplateServiceImpl) CreateTemplate(ctx context.Context, customerID, projectID string, t *models.IssueTemplate, userID string) (*models.IssueTemplate, error) {
+	if err := s.ensureProject(ctx, customerID, projectID); err != nil {
+		return nil, err
+	}
+
+	t.ID = uuid.NewString()
+	t.CustomerID = customerID
+	t.ProjectID = projectID
+	t.CreatedBy = userID
+
+	if err := s.validate(ctx, t); err != nil {
+		return nil, err
+	}
+
+	if err := s.templateRepo.CreateTemplate(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// UpdateTemplate replaces every editable field of the template. Issues
+// created from it earlier are not touched.
+func (s *IssueTemplateServiceImpl) UpdateTemplate(ctx context.Context, customerID, projectID, templateID string, t *models.IssueTemplate, userID string) (*models.IssueTemplate, error) {
+	cur, err := s.GetTemplate(ctx, customerID, projectID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	t.ID = cur.ID
+	t.CustomerID = cur.CustomerID
+	t.ProjectID = cur.ProjectID
+	t.CreatedBy = cur.CreatedBy
+	t.CreatedAt = cur.CreatedAt
+
+	if err := s.validate(ctx, t); err != nil {
+		return nil, err
+	}
+
+	if err := s.templateRepo.UpdateTemplate(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *IssueTemplateServiceImpl) DeleteTemplate(ctx context.Context, customerID, projectID, templateID, userID string) error {
+	if _, err := s.GetTemplate(ctx, customerID, projectID, templateID); err != nil {
+		return err
+	}
+	return s.templateRepo.DeleteTemplate(ctx, templateID)
+}
+
+func (s *IssueTemplateServiceImpl) GetTemplate(ctx context.Context, customerID, projectID, templateID string) (*models.IssueTemplate, error) {
+	t, err := s.templateRepo.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.ProjectID != projectID || t.CustomerID != customerID {
+		return nil, models.ErrTemplateNotFound
+	}
+	return t, nil
+}
+
+func (s *IssueTemplateServiceImpl) ListTemplatesByProject(ctx context.Context, customerID, projectID string) ([]models.IssueTemplate, error) {
+	if err := s.ensureProject(ctx, customerID, projectID); err != nil {
+		return nil, err
+	}
+	return s.templateRepo.ListTemplatesByProject(ctx, projectID)
+}
+
+func (s *IssueTemplateServiceImpl) validate(ctx context.Context, t *models.IssueTemplate) error {
+	t.Name = strings.TrimSpace(t.Name)
+	if t.Name == "" {
+		return errors.New("name is required")
+	}
+
+	if t.Priority != nil && !validPriorities[*t.Priority] {
+		return ErrInvalidPriority
+	}
+
+	if t.AssignedTo != nil {
+		u, err := s.userRepo.GetByID(ctx, *t.AssignedTo)
+		if err != nil || u == nil || u.CustomerID != t.CustomerID {
+			return errors.New("invalid assignee")
+		}
+	}
+
+	for _, id := range t.LabelIDs {
+		l, err := s.labelRepo.GetLabelByID(ctx, id)
+		if err != nil || l == nil || l.ProjectID != t.ProjectID {
+			return errors.New("label not found in project")
+		}
+	}
+
+	for _, cl := range t.Checklists {
+		if strings.TrimSpace(cl.Title) == "" {
+			return errors.New("checklist title is required")
+		}
+	}
+	for _, st := range t.Subtasks {
+		if strings.TrimSpace(st.Title) == "" {
+			return errors.New("subtask title is required")
+		}
+	}
+
+	return nil
+}
+
+func (s *IssueTemplateServiceImpl) ensureProject(ctx context.Context, customerID, projectID string) error {
+	pr, err := s.projectRepo.GetByID(ctx, projectID, customerID)
+	if err != nil || pr == nil {
+		return errors.New("project not found")
+	}
+	return nil
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   MATERIALIZATION (shared by CreateIssue and CreateCard)
+// ─────────────────────────────────────────────────────────────
+//
+
+// loadIssueTemplate fetches a template and checks it belongs to projectID.
+func loadIssueTemplate(ctx context.Context, templates repo.IssueTemplateRepository, projectID, templateID string) (*models.IssueTemplate, error) {
+	t, err := templates.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.ProjectID != projectID {
+		return nil, models.ErrTemplateNotFound
+	}
+	return t, nil
+}
+
+// applyIssueTemplate fills issue from tpl. Values the caller provided win;
+// the title prefix is added unless the title already carries it.
+func applyIssueTemplate(issue *models.Issue, tpl *models.IssueTemplate) {
+	if tpl.TitlePrefix != "" && !strings.HasPrefix(issue.Title, tpl.TitlePrefix) {
+		issue.Title = tpl.TitlePrefix + issue.Title
+	}
+	if issue.Description == "" {
+		issue.Description = tpl.Description
+	}
+	if issue.Priority == "" && tpl.Priority != nil {
+		issue.Priority = *tpl.Priority
+	}
+	if issue.AssignedTo == nil {
+		issue.AssignedTo = tpl.AssignedTo
+	}
+	if len(tpl.CustomFields) > 0 {
+		fields := map[string]interface{}{}
+		for k, v := range tpl.CustomFields {
+			fields[k] = v
+		}
+		for k, v := range issue.CustomFields {
+			fields[k] = v
+		}
+		issue.CustomFields = fields
+	}
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+)
+
+// resolveBoard returns the board with boardID, or the project's default
+// board when boardID is empty. The caller has checked membership.
+func (s *KanbanServiceImpl) resolveBoard(ctx context.Context, projectID, boardID, userID string) (*models.Board, error) {
+	if boardID == "" {
+		return s.kanbanRepo.EnsureDefaultBoard(ctx, projectID, userID)
+	}
+	b, err := s.kanbanRepo.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	if b.ProjectID != projectID {
+		return nil, models.ErrBoardNotFound
+	}
+	return b, nil
+}
+
+// BoardOfColumn returns the board a column belongs to, or "" when the
+// column is unknown. The routes use it to pick the WebSocket room.
+func (s *KanbanServiceImpl) BoardOfColumn(columnID string) string {
+	col, err := s.kanbanRepo.GetColumnByID(context.Background(), columnID)
+	if err != nil {
+		return ""
+	}
+	return col.BoardID
+}
+
+// ResolveBoardID checks that boardID is a board of the project, or picks
+// the project's default board when it is empty. The WebSocket routes use it
+// to choose the room a client joins.
+func (s *KanbanServiceImpl) ResolveBoardID(projectID, boardID, userID string) (string, error) {
+	ctx := context.Background()
+
+	if err := s.ensureMember(ctx, projectID, userID); err != nil {
+		return "", err
+	}
+	b, err := s.resolveBoard(ctx, projectID, boardID, userID)
+	if err != nil {
+		return "", err
+	}
+	return b.ID, nil
+}
+
+// seedBoardFilter fills in the fields a card created on the board needs
+// to match the board's filter, so that it does not vanish from the board
+// it was just added to: the first filtered priority when the card has
+// none, and the first filtered label ("" for none) when it comes without
+// labels. Whatever the caller or a template already set is kept, and the
+// card is never assigned to anyone; filters on assignees, text, dates or
+// recency are left alone. It runs before the card is written so that the
+// creating tx applies the seed with the card.
+func (s *KanbanServiceImpl) seedBoardFilter(ctx context.Context, boardID string, card *models.Issue, labelIDs []string) (string, error) {
+	b, err := s.kanbanRepo.GetBoard(ctx, boardID)
+	if err != nil {
+		return "", err
+	}
+	f := b.Filter
+
+	if card.Priority == "" && len(f.Priorities) > 0 {
+		card.Priority = f.Priorities[0]
+	}
+	if len(labelIDs) == 0 && len(f.LabelIDs) > 0 {
+		return f.LabelIDs[0], nil
+	}
+	return "", nil
+}
+
+//
+// ---------------------------------------------------------------
+// BOARDS
+// ---------------------------------------------------------------
+//
+
+func (s *KanbanServiceImpl) ListBoards(projectID, userID string) ([]models.Board, error) {
+	ctx := context.Background()
+
+	if err := s.ensureMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+	// Make sure the default board is always listed
+	if _, err := s.kanbanRepo.EnsureDefaultBoard(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+	return s.kanbanRepo.ListBoards(ctx, projectID)
+}
+
+func (s *KanbanServiceImpl) CreateBoard(projectID, name string, filter models.BoardFilter, userID string) (*models.Board, error) {
+	ctx := context.Background()
+
+	if err := s.ensureMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+	name, err := s.checkBoard(ctx, projectID, "", name, &filter)
+	if err != nil {
+		return nil, err
+	}
+	// The default board comes first so the new one never takes its place
+	if _, err := s.kanbanRepo.EnsureDefaultBoard(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	b := &models.Board{
+		ID:        models.NewUUID(),
+		ProjectID: projectID,
+		Name:      name,
+		Filter:    filter,
+		CreatedBy: &userID,
+	}
+	if err := s.kanbanRepo.CreateBoard(ctx, b); err != nil {
+		return nil, err
+	}
+
+	_ = s.activity.LogProject(ctx, projectID, &userID, models.ActivityBoardCreated, map[string]interface{}{
+		"board_id": b.ID,
+		"name":     b.Name,
+		"filter":   b.Filter,
+	})
+	return b, nil
+}
+
+func (s *KanbanServiceImpl) UpdateBoard(projectID, boardID, name string, filter models.BoardFilter, userID string) (*models.Board, error) {
+	ctx := context.Background()
+
+	if err := s.ensureMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+	b, err := s.resolveBoard(ctx, projectID, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.IsDefault && !filter.IsEmpty() {
+		return nil, models.ErrDefaultBoardFilter
+	}
+
+	old := *b
+	if b.Name, err = s.checkBoard(ctx, projectID, b.ID, name, &filter); err != nil {
+		return nil, err
+	}
+	b.Filter = filter
+	if err := s.kanbanRepo.UpdateBoard(ctx, b); err != nil {
+		return nil, err
+	}
+
+	_ = s.activity.LogProject(ctx, projectID, &userID, models.ActivityBoardUpdated, map[string]interface{}{
+		"board_id": b.ID,
+		"old":      map[string]interface{}{"name": old.Name, "filter": old.Filter},
+		"new":      map[string]interface{}{"name": b.Name, "filter": b.Filter},
+	})
+	return b, nil
+}
+
+// DeleteBoard removes a board and its columns. Boards that still hold
+// live cards are refused; cards in the trash are detached from the
+// columns like on a column delete.
+func (s *KanbanServiceImpl) DeleteBoard(projectID, boardID, userID string) error {
+	ctx := context.Background()
+
+	if err := s.ensureMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+	b, err := s.resolveBoard(ctx, projectID, boardID, userID)
+	if err != nil {
+		return err
+	}
+	if b.IsDefault {
+		return models.ErrDefaultBoardDelete
+	}
+
+	err = s.kanbanRepo.Tx(ctx, func(tx repo.KanbanRepository) error {
+		cols, err := tx.ListBoardColumnIDs(ctx, b.ID)
+		if err != nil {
+			return err
+		}
+		// Each column is locked before its cards are counted, so no card
+		// enters the board while it is being deleted.
+		for _, columnID := range cols {
+			if _, err := tx.LockColumn(ctx, columnID); err != nil {
+				return err
+			}
+			cards, err := tx.CountCards(ctx, columnID)
+			if err != nil {
+				return err
+			}
+			if cards > 0 {
+				return models.ErrBoardNotEmpty
+			}
+			if err := tx.DetachTrashedCards(ctx, columnID); err != nil {
+				return err
+			}
+		}
+		return tx.DeleteBoard(ctx, b.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	_ = s.activity.LogProject(ctx, projectID, &userID, models.ActivityBoardDeleted, map[string]interface{}{
+		"board_id": b.ID,
+		"name":     b.Name,
+	})
+	return nil
+}
+
+// checkBoard validates a board and returns its trimmed name, which must be
+// unique on the project (selfID is the board being edited).
+func (s *KanbanServiceImpl) checkBoard(ctx context.Context, projectID, selfID, name string, f *models.BoardFilter) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.New("name is required")
+	}
+	if err := validateBoardFilter(f); err != nil {
+		return "", err
+	}
+
+	existing, err := s.kanbanRepo.ListBoards(ctx, projectID)
+	if err != nil {
+		return "", err
+	}
+	for _, b := range existing {
+		if b.ID != selfID && strings.EqualFold(b.Name, name) {
+			return "", errors.New("a board with this name already exists")
+		}
+	}
+	return name, nil
+}
+
+func containsString(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"bugforge-backend/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// maxQuickFiltersPerRequest caps the quick_filter ids one board request
+// may apply.
+const maxQuickFiltersPerRequest = 10
+
+// parseBoardFilter reads the ad-hoc board filter from the query string. It
+// takes the parameter names of the issue list (assigned_to, priority,
+// search) plus label_id, due_from, due_to, mine and updated_within (days).
+// Lists are comma-separated.
+func parseBoardFilter(q url.Values) (models.BoardFilter, error) {
+	f := models.BoardFilter{
+		AssigneeIDs: splitList(q.Get("assigned_to")),
+		LabelIDs:    splitList(q.Get("label_id")),
+		Priorities:  splitList(q.Get("priority")),
+		Text:        strings.TrimSpace(q.Get("search")),
+		DueFrom:     q.Get("due_from"),
+		DueTo:       q.Get("due_to"),
+		OnlyMine:    q.Get("mine") == "true",
+	}
+	if v := q.Get("updated_within"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil {
+			return f, invalidFilter("invalid updated_within, expected a number of days")
+		}
+		f.UpdatedWithinDays = days
+	}
+	return f, validateBoardFilter(&f)
+}
+
+// validateBoardFilter checks a filter before it is applied or saved; its
+// errors wrap models.ErrInvalidBoardFilter.
+func validateBoardFilter(f *models.BoardFilter) error {
+	for _, id := range f.AssigneeIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return invalidFilter("invalid assigned_to id %q", id)
+		}
+	}
+	for _, id := range f.LabelIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return invalidFilter("invalid label_id %q", id)
+		}
+	}
+	for _, p := range f.Priorities {
+		if !validPriorities[p] {
+			return invalidFilter("invalid priority %q", p)
+		}
+	}
+	if f.DueFrom != "" {
+		if _, err := time.Parse(worklogDateLayout, f.DueFrom); err != nil {
+			return invalidFilter("invalid due_from date, expected YYYY-MM-DD")
+		}
+	}
+	if f.DueTo != "" {
+		if _, err := time.Parse(worklogDateLayout, f.DueTo); err != nil {
+			return invalidFilter("invalid due_to date, expected YYYY-MM-DD")
+		}
+	}
+	if f.DueFrom != "" && f.DueTo != "" && f.DueFrom > f.DueTo {
+		return invalidFilter("due_from must not be after due_to")
+	}
+	if f.UpdatedWithinDays < 0 {
+		return invalidFilter("updated_within must not be negative")
+	}
+	return nil
+}
+
+func invalidFilter(format string, args ...any) error {
+	return fmt.Errorf("%w: "+format, append([]any{models.ErrInvalidBoardFilter}, args...)...)
+}
+
+// boardFilters collects the filters a board request applies: the board's
+// own, the ad-hoc one and the saved quick filters named in quick_filter.
+// "Only my issues" is resolved against the viewer here, as a filter of its
+// own so that it still narrows any assignee list it came with.
+func (s *KanbanServiceImpl) boardFilters(ctx context.Context, board *models.Board, userID string, q url.Values) ([]models.BoardFilter, error) {
+	adHoc, err := parseBoardFilter(q)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []models.BoardFilter{}
+	if !board.Filter.IsEmpty() {
+		filters = append(filters, board.Filter)
+	}
+	if !adHoc.IsEmpty() {
+		filters = append(filters, adHoc)
+	}
+
+	ids := splitList(q.Get("quick_filter"))
+	if len(ids) > maxQuickFiltersPerRequest {
+		return nil, invalidFilter("at most %d quick filters can be applied at once", maxQuickFiltersPerRequest)
+	}
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, invalidFilter("invalid quick_filter id %q", id)
+		}
+		qf, err := s.kanbanRepo.GetQuickFilter(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if qf.ProjectID != board.ProjectID {
+			return nil, models.ErrQuickFilterNotFound
+		}
+		filters = append(filters, qf.Filter)
+	}
+
+	out := make([]models.BoardFilter, 0, len(filters))
+	for _, f := range filters {
+		if f.OnlyMine {
+			out = append(out, models.BoardFilter{AssigneeIDs: []string{userID}})
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+//
+// ---------------------------------------------------------------
+// QUICK FILTERS
+// ---------------------------------------------------------------
+//
+
+func (s *KanbanServiceImpl) ListQuickFilters(projectID, userID string) ([]models.QuickFilter, error) {
+	ctx := context.Background()
+
+	if err := s.ensureMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+	return s.kanbanRepo.ListQuickFilters(ctx, projectID)
+}
+
+func (s *KanbanServiceImpl) CreateQuickFilter(projectID, name string, filter models.BoardFilter, userID string) (*models.QuickFilter, error) {
+	ctx := context.Background()
+
+	if err := s.ensureMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+	name, err := s.checkQuickFilter(ctx, projectID, "", name, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	qf := &models.QuickFilter{
+		ID:        models.NewUUID(),
+		ProjectID: projectID,
+		Name:      name,
+		Filter:    filter,
+		CreatedBy: userID,
+	}
+	if err := s.kanbanRepo.CreateQuickFilter(ctx, qf); err != nil {
+		return nil, err
+	}
+	return qf, nil
+}
+
+func (s *KanbanServiceImpl) UpdateQuickFilter(projectID, filterID, name string, filter models.BoardFilter, userID string) (*models.QuickFilter, error) {
+	ctx := context.Background()
+
+	if err := s.ensureMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+	qf, err := s.kanbanRepo.GetQuickFilter(ctx, filterID)
+	if err != nil {
+		return nil, err
+	}
+	if qf.ProjectID != projectID {
+		return nil, models.ErrQuickFilterNotFound
+	}
+
+	if qf.Name, err = s.checkQuickFilter(ctx, projectID, filterID, name, &filter); err != nil {
+		return nil, err
+	}
+	qf.Filter = filter
+	if err := s.kanbanRepo.UpdateQuickFilter(ctx, qf); err != nil {
+		return nil, err
+	}
+	return qf, nil
+}
+
+func (s *KanbanServiceImpl) DeleteQuickFilter(projectID, filterID, userID string) error {
+	ctx := context.Background()
+
+	if err := s.ensureMember(ctx, projectID, userID); err != nil {
+		return err
+	}
+	qf, err := s.kanbanRepo.GetQuickFilter(ctx, filterID)
+	if err != nil {
+		return err
+	}
+	if qf.ProjectID != projectID {
+		return models.ErrQuickFilterNotFound
+	}
+	return s.kanbanRepo.DeleteQuickFilter(ctx, filterID)
+}
+
+// checkQuickFilter validates a quick filter and returns its trimmed name,
+// which must be unique on the project (selfID is the filter being edited).
+func (s *KanbanServiceImpl) checkQuickFilter(ctx context.Context, projectID, selfID, name string, f *models.BoardFilter) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.New("name is required")
+	}
+	if f.IsEmpty() {
+		return "", errors.New("filter is empty")
+	}
+	if err := validateBoardFilter(f); err != nil {
+		return "", err
+	}
+
+	existing, err := s.kanbanRepo.ListQuickFilters(ctx, projectID)
+	if err != nil {
+		return "", err
+	}
+	for _, qf := range existing {
+		if qf.ID != selfID && strings.EqualFold(qf.Name, name) {
+			return "", errors.New("a quick filter with this name already exists")
+		}
+	}
+	return name, nil
+}
+
+func (s *KanbanServiceImpl) ensureMember(ctx context.Context, projectID, userID string) error {
+	isMember, err := s.projectMemberRepo.IsMember(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return errors.New("forbidden")
+	}
+	return nil
+}
+
+func splitList(v string) []string {
+	var out []string
+	for _, p := range strings.Split(v, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"bugforge-backend/internal/models"
+)
+
+func TestParseBoardFilter(t *testing.T) {
+	const (
+		user  = "6f1c2d3e-0000-4000-8000-000000000001"
+		label = "6f1c2d3e-0000-4000-8000-000000000002"
+	)
+
+	q := url.Values{
+		"assigned_to":    {user},
+		"label_id":       {" " + label + " ,,"},
+		"priority":       {"high,low"},
+		"search":         {"  login bug "},
+		"due_from":       {"2024-03-01"},
+		"due_to":         {"2024-03-31"},
+		"mine":           {"true"},
+		"updated_within": {"7"},
+	}
+	got, err := parseBoardFilter(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := models.BoardFilter{
+		AssigneeIDs:       []string{user},
+		LabelIDs:          []string{label},
+		Priorities:        []string{"high", "low"},
+		Text:              "login bug",
+		DueFrom:           "2024-03-01",
+		DueTo:             "2024-03-31",
+		OnlyMine:          true,
+		UpdatedWithinDays: 7,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("filter = %+v, want %+v", got, want)
+	}
+
+	empty, err := parseBoardFilter(url.Values{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !empty.IsEmpty() {
+		t.Errorf("filter without parameters = %+v, want empty", empty)
+	}
+}
+
+func TestParseBoardFilterRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		val  string
+	}{
+		{name: "assignee id", key: "assigned_to", val: "bob"},
+		{name: "label id", key: "label_id", val: "6f1c2d3e,urgent"},
+		{name: "priority", key: "priority", val: "high,asap"},
+		{name: "due_from", key: "due_from", val: "01.03.2024"},
+		{name: "due_to", key: "due_to", val: "2024-02-30"},
+		{name: "updated_within not a number", key: "updated_within", val: "a week"},
+		{name: "negative updated_within", key: "updated_within", val: "-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseBoardFilter(url.Values{tt.key: {tt.val}})
+			if !errors.Is(err, models.ErrInvalidBoardFilter) {
+				t.Fatalf("%s=%q: err = %v, want %v", tt.key, tt.val, err, models.ErrInvalidBoardFilter)
+			}
+		})
+	}
+}
+
+func TestParseBoardFilterDueRange(t *testing.T) {
+	_, err := parseBoardFilter(url.Values{"due_from": {"2024-04-01"}, "due_to": {"2024-03-01"}})
+	if !errors.Is(err, models.ErrInvalidBoardFilter) {
+		t.Fatalf("err = %v, want %v", err, models.ErrInvalidBoardFilter)
+	}
+
+	if _, err := parseBoardFilter(url.Values{"due_from": {"2024-03-01"}, "due_to": {"2024-03-01"}}); err != nil {
+		t.Fatalf("single day range rejected: %v", err)
+	}
+}
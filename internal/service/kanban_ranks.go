@@ -0,0 +1,27 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+)
+
+// rankAt picks a rank between the neighbours of a target position. Ties
+// left behind by concurrent moves leave no room between two neighbours;
+// the list is then rebalanced and the neighbours read again.
+func rankAt(neighbors func() (string, string, error), rebalance func() error) (string, error) {
+	prev, next, err := neighbors()
+	if err != nil {
+		return "", err
+	}
+	rank, err := models.RankBetween(prev, next)
+	if err != models.ErrRankCollision {
+		return rank, err
+	}
+
+	if err := rebalance(); err != nil {
+		return "", err
+	}
+	if prev, next, err = neighbors(); err != nil {
+		return "", err
+	}
+	return models.RankBetween(prev, next)
+}
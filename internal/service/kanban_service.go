@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"errors"
+	"net/url"
 
 	"bugforge-backend/internal/models"
 	repo "bugforge-backend/internal/repository/interfaces"
+	service "bugforge-backend/internal/service/interfaces"
 )
 
 // KanbanServiceImpl contains the repositories it needs.
@@ -15,6 +17,9 @@ type KanbanServiceImpl struct {
 	projectRepo       repo.ProjectRepository       // kept in case you need project CRUD in future
 	projectMemberRepo repo.ProjectMemberRepository
 	kanbanRepo        repo.KanbanRepository
+	templateRepo      repo.IssueTemplateRepository
+	sprintRepo        repo.SprintRepository
+	activity          service.ActivityService
 }
 
 func NewKanbanService(
@@ -22,18 +27,35 @@ func NewKanbanService(
 	projectRepo repo.ProjectRepository,
 	projectMemberRepo repo.ProjectMemberRepository,
 	kanbanRepo repo.KanbanRepository,
+	templateRepo repo.IssueTemplateRepository,
+	sprintRepo repo.SprintRepository,
+	activitySvc service.ActivityService,
 ) *KanbanServiceImpl {
 	return &KanbanServiceImpl{
 		issueRepo:         issueRepo,
 		projectRepo:       projectRepo,
 		projectMemberRepo: projectMemberRepo,
 		kanbanRepo:        kanbanRepo,
+		templateRepo:      templateRepo,
+		sprintRepo:        sprintRepo,
+		activity:          activitySvc,
 	}
 }
 
 
-func (s *KanbanServiceImpl) GetBoard(projectID string, userID string) (*models.KanbanBoard, error) {
+// GetBoard returns a board of the project, the default one when boardID
+// is empty. sprint selects scrum mode: "active" for the running sprint or
+// a sprint id; empty shows every card. swimlanes groups the board
+// (assignee, priority, epic or label). On top of the board's own filter
+// the cards can be filtered ad hoc (see parseBoardFilter) and by saved
+// quick filters (quick_filter=<id>,<id>).
+func (s *KanbanServiceImpl) GetBoard(projectID string, boardID string, userID string, q url.Values) (*models.KanbanBoard, error) {
     ctx := context.Background()
+    sprint, groupBy := q.Get("sprint"), q.Get("swimlanes")
+
+    if groupBy != "" && !models.SwimlaneGroups[groupBy] {
+        return nil, errors.New("invalid swimlane group")
+    }
 
     // validate membership
     isMember, err := s.projectMemberRepo.IsMember(ctx, projectID, userID)
@@ -44,13 +66,69 @@ func (s *KanbanServiceImpl) GetBoard(projectID string, userID string) (*models.K
         return nil, errors.New("forbidden")
     }
 
-    cols, err := s.kanbanRepo.GetColumnsWithCards(ctx, projectID)
+    var sp *models.Sprint
+    switch sprint {
+    case "":
+    case "active":
+        sp, err = s.sprintRepo.GetActiveSprint(ctx, projectID)
+        if err != nil {
+            return nil, err
+        }
+        if sp == nil {
+            return nil, errors.New("project has no active sprint")
+        }
+    default:
+        sp, err = s.sprintRepo.GetSprintByID(ctx, sprint)
+        if err != nil {
+            return nil, err
+        }
+        if sp == nil || sp.ProjectID != projectID {
+            return nil, models.ErrSprintNotFound
+        }
+    }
+
+    var sprintID *string
+    if sp != nil {
+        sprintID = &sp.ID
+    }
+
+    b, err := s.resolveBoard(ctx, projectID, boardID, userID)
+    if err != nil {
+        return nil, err
+    }
+
+    filters, err := s.boardFilters(ctx, b, userID, q)
+    if err != nil {
+        return nil, err
+    }
+
+    cols, err := s.kanbanRepo.GetColumnsWithCards(ctx, b.ID, sprintID, filters)
+    if err != nil {
+        return nil, err
+    }
+
+    archived, err := s.kanbanRepo.ListArchivedColumns(ctx, b.ID)
     if err != nil {
         return nil, err
     }
 
     board := &models.KanbanBoard{
-        Columns: cols,
+        Board:           b,
+        Columns:         cols,
+        Sprint:          sp,
+        ArchivedColumns: archived,
+    }
+    if len(filters) > 0 {
+        board.Filters = filters
+    }
+
+    if groupBy != "" {
+        keys, err := s.kanbanRepo.ListCardLanes(ctx, projectID, groupBy, nil)
+        if err != nil {
+            return nil, err
+        }
+        board.GroupBy = groupBy
+        board.Swimlanes = buildSwimlanes(cols, keys, groupBy)
     }
 
     return board, nil
@@ -62,8 +140,11 @@ func (s *KanbanServiceImpl) GetBoard(projectID string, userID string) (*models.K
 // ---------------------------------------------------------------
 //
 
+// CreateColumn appends a column to a board of the project, the default
+// one when boardID is empty.
 func (s *KanbanServiceImpl) CreateColumn(
 	projectID string,
+	boardID string,
 	name string,
 	userID string,
 ) (*models.KanbanColumn, error) {
@@ -79,8 +160,13 @@ func (s *KanbanServiceImpl) CreateColumn(
 		return nil, errors.New("forbidden")
 	}
 
-	// Determine next order
-	order, err := s.kanbanRepo.GetNextColumnOrder(ctx, projectID)
+	board, err := s.resolveBoard(ctx, projectID, boardID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Append after the last column
+	last, count, err := s.kanbanRepo.GetColumnTail(ctx, board.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -88,14 +174,24 @@ func (s *KanbanServiceImpl) CreateColumn(
 	col := &models.KanbanColumn{
 		ID:        models.NewUUID(),
 		ProjectID: projectID,
+		BoardID:   board.ID,
 		Name:      name,
-		Order:     order,
+		Order:     count + 1,
+		Rank:      models.RankAfter(last),
+		WIPMode:   models.WIPModeSoft,
 	}
 
 	if err := s.kanbanRepo.CreateColumn(ctx, col); err != nil {
 		return nil, err
 	}
 
+	_ = s.activity.LogProject(ctx, projectID, &userID, models.ActivityColumnCreated, map[string]interface{}{
+		"column_id": col.ID,
+		"board_id":  col.BoardID,
+		"name":      col.Name,
+		"order":     col.Order,
+	})
+
 	return col, nil
 }
 
@@ -110,43 +206,113 @@ func (s *KanbanServiceImpl) CreateCard(
 	columnID string,
 	title string,
 	description string,
+	templateID *string,
 	userID string,
-) (*models.Issue, error) {
+) (*models.Issue, *models.WIPWarning, error) {
 
 	ctx := context.Background()
 
 	// Validate project membership via projectMemberRepo
 	isMember, err := s.projectMemberRepo.IsMember(ctx, projectID, userID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if !isMember {
-		return nil, errors.New("forbidden")
+		return nil, nil, errors.New("forbidden")
 	}
 
-	// Determine next order inside the column
-	nextOrder, err := s.kanbanRepo.GetNextOrder(ctx, columnID)
-	if err != nil {
-		return nil, err
+	col, err := s.kanbanRepo.GetColumnByID(ctx, columnID)
+	if err != nil || col.ProjectID != projectID {
+		return nil, nil, errors.New("column_not_found")
+	}
+
+	// A template materializes the card together with its labels,
+	// checklists and subtasks; the rank is assigned and the WIP limit
+	// checked inside that tx.
+	if templateID != nil {
+		tpl, err := loadIssueTemplate(ctx, s.templateRepo, projectID, *templateID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		card := &models.Issue{
+			ID:          models.NewUUID(),
+			ProjectID:   projectID,
+			ColumnID:    columnID,
+			Title:       title,
+			Description: description,
+			Status:      initialIssueStatus,
+			CreatedBy:   userID,
+		}
+		applyIssueTemplate(card, tpl)
+		label, err := s.seedBoardFilter(ctx, col.BoardID, card, tpl.LabelIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if label != "" {
+			tpl.LabelIDs = append(tpl.LabelIDs, label)
+		}
+		if card.Priority == "" {
+			card.Priority = "medium"
+		}
+
+		warning, err := s.issueRepo.CreateFromTemplate(ctx, card, tpl)
+		if err != nil {
+			return nil, nil, err
+		}
+		s.logCardCreated(ctx, card, userID)
+		return card, warning, nil
 	}
 
 	card := &models.Issue{
 		ID:          models.NewUUID(),
 		ProjectID:   projectID,
 		ColumnID:    columnID,
-		Order:       nextOrder,
 		Title:       title,
 		Description: description,
-		Status:      "todo",
-		Priority:    "medium",
+		Status:      initialIssueStatus,
+		IssueType:   models.IssueTypeTask,
 		CreatedBy:   userID,
 	}
+	label, err := s.seedBoardFilter(ctx, col.BoardID, card, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if card.Priority == "" {
+		card.Priority = "medium"
+	}
 
-	if err := s.kanbanRepo.CreateCard(ctx, card); err != nil {
-		return nil, err
+	// Append after the last card of the column, with the column locked
+	var warning *models.WIPWarning
+	err = s.kanbanRepo.Tx(ctx, func(tx repo.KanbanRepository) error {
+		w, err := admitCards(ctx, tx, columnID, 1, "column_not_found")
+		if err != nil {
+			return err
+		}
+		warning = w
+
+		last, count, err := tx.GetCardTail(ctx, columnID)
+		if err != nil {
+			return err
+		}
+
+		card.Order = count + 1
+		card.Rank = models.RankAfter(last)
+		if err := tx.CreateCard(ctx, card); err != nil {
+			return err
+		}
+		if label == "" {
+			return nil
+		}
+		return tx.ApplyLaneMove(ctx, projectID, card.ID, models.SwimlaneLabel, nil, &label)
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return card, nil
+	s.logCardCreated(ctx, card, userID)
+
+	return card, warning, nil
 }
 
 //
@@ -159,15 +325,16 @@ func (s *KanbanServiceImpl) MoveCard(
     cardID string,
     toColumnID string,
     newOrder int,
+    lane *models.LaneMove,
     userID string,
-) (*models.Issue, string, error) {
+) (*models.Issue, string, *models.WIPWarning, error) {
 
     ctx := context.Background()
 
     // 1. Fetch existing card
     card, err := s.kanbanRepo.GetCardByID(ctx, cardID)
     if err != nil {
-        return nil, "", errors.New("card_not_found")
+        return nil, "", nil, errors.New("card_not_found")
     }
 
     fromColumnID := card.ColumnID
@@ -176,51 +343,95 @@ func (s *KanbanServiceImpl) MoveCard(
     // 2. Validate membership
     isMember, err := s.projectMemberRepo.IsMember(ctx, card.ProjectID, userID)
     if err != nil {
-        return nil, "", err
+        return nil, "", nil, err
     }
     if !isMember {
-        return nil, "", errors.New("forbidden")
+        return nil, "", nil, errors.New("forbidden")
+    }
+
+    if fromColumnID != toColumnID {
+        col, err := s.kanbanRepo.GetColumnByID(ctx, toColumnID)
+        if err != nil || col.ProjectID != card.ProjectID {
+            return nil, "", nil, errors.New("column_not_found")
+        }
+    }
+
+    // 3. A drop into another swimlane rewrites the grouped attribute
+    var laneChg *laneChange
+    if lane != nil {
+        laneChg, err = s.checkLaneMove(ctx, card, lane)
+        if err != nil {
+            return nil, "", nil, err
+        }
     }
 
-    // 3. Perform move inside TX
+    // 4. Perform move inside TX. Every move holds the target column's lock
+    // (as RebalanceCards does) while it checks the WIP limit and picks a
+    // rank, then only the moved card is written: it gets a rank between its
+    // new neighbours.
+    var warning *models.WIPWarning
     err = s.kanbanRepo.Tx(ctx, func(tx repo.KanbanRepository) error {
 
-        // Moving to a different column
         if fromColumnID != toColumnID {
-
-            if err := tx.ShiftOrdersDown(ctx, fromColumnID, oldOrder); err != nil {
+            w, err := admitCards(ctx, tx, toColumnID, 1, "column_not_found")
+            if err != nil {
                 return err
             }
+            warning = w
+        } else if _, err := tx.LockColumn(ctx, toColumnID); err != nil {
+            return err
+        }
+
+        rank, err := rankAt(
+            func() (string, string, error) {
+                return tx.GetCardNeighbors(ctx, toColumnID, cardID, newOrder)
+            },
+            func() error { return tx.RebalanceCards(ctx, toColumnID) },
+        )
+        if err != nil {
+            return err
+        }
 
-            if err := tx.ShiftOrders(ctx, toColumnID, newOrder); err != nil {
+        if fromColumnID != toColumnID {
+            if err := tx.RecordColumnMove(ctx, cardID, fromColumnID, toColumnID); err != nil {
                 return err
             }
+        }
 
-        } else {
-            // Reordering inside SAME column
-            if newOrder < oldOrder {
-                if err := tx.ShiftRangeUp(ctx, fromColumnID, newOrder, oldOrder); err != nil {
-                    return err
-                }
-            } else if newOrder > oldOrder {
-                if err := tx.ShiftRangeDown(ctx, fromColumnID, newOrder, oldOrder); err != nil {
-                    return err
-                }
+        if lane != nil {
+            if err := applyLaneMove(ctx, tx, card, lane, laneChg); err != nil {
+                return err
             }
         }
 
         // Update card
         card.ColumnID = toColumnID
         card.Order = newOrder
+        card.Rank = rank
 
         return tx.UpdateCardPosition(ctx, card)
     })
 
     if err != nil {
-        return nil, "", err
+        return nil, "", nil, err
     }
 
-    return card, fromColumnID, nil
+    if fromColumnID != toColumnID || oldOrder != newOrder {
+        _ = s.activity.Log(ctx, cardID, &userID, models.ActivityCardMoved, map[string]interface{}{
+            "from_column_id": fromColumnID,
+            "from_column":    s.columnName(ctx, fromColumnID),
+            "to_column_id":   toColumnID,
+            "to_column":      s.columnName(ctx, toColumnID),
+            "old_order":      oldOrder,
+            "new_order":      newOrder,
+        })
+    }
+
+    if lane != nil {
+        s.logLaneMove(ctx, userID, lane, laneChg)
+    }
+
+    return card, fromColumnID, warning, nil
 }
 
 func (s *KanbanServiceImpl) ReorderColumn(
@@ -241,41 +452,40 @@ func (s *KanbanServiceImpl) ReorderColumn(
         return errors.New("forbidden")
     }
 
-    // Load all columns
-    cols, err := s.kanbanRepo.GetColumnsWithCards(ctx, projectID)
-    if err != nil {
-        return err
-    }
-
-    // Find target column + reorder logic
-    var oldOrder int
-    for _, col := range cols {
-        if col.ID == columnID {
-            oldOrder = col.Order
-            break
-        }
-    }
-
-    if oldOrder == 0 {
+    // Find target column
+    col, err := s.kanbanRepo.GetColumnByID(ctx, columnID)
+    if err != nil || col.ProjectID != projectID {
         return errors.New("column_not_found")
     }
+    oldOrder := col.Order
+    if newOrder == oldOrder {
+        return nil
+    }
 
-    // Execute DB updates inside TX
-    return s.kanbanRepo.Tx(ctx, func(tx repo.KanbanRepository) error {
-
-        // Reorder logic:
-        if newOrder < oldOrder {
-            // Shift columns DOWN (order + 1)
-            return tx.ReorderColumnsShiftUp(ctx, projectID, newOrder, oldOrder, columnID)
-        }
-
-        if newOrder > oldOrder {
-            // Shift columns UP (order - 1)
-            return tx.ReorderColumnsShiftDown(ctx, projectID, oldOrder, newOrder, columnID)
+    // Give the column a rank between its new neighbours
+    err = s.kanbanRepo.Tx(ctx, func(tx repo.KanbanRepository) error {
+        rank, err := rankAt(
+            func() (string, string, error) {
+                return tx.GetColumnNeighbors(ctx, col.BoardID, columnID, newOrder)
+            },
+            func() error { return tx.RebalanceColumns(ctx, col.BoardID) },
+        )
+        if err != nil {
+            return err
         }
+        return tx.UpdateColumnRank(ctx, columnID, rank)
+    })
+    if err != nil {
+        return err
+    }
 
-        return nil
+    _ = s.activity.LogProject(ctx, projectID, &userID, models.ActivityColumnReordered, map[string]interface{}{
+        "column_id": columnID,
+        "name":      col.Name,
+        "old_order": oldOrder,
+        "new_order": newOrder,
     })
+    return nil
 }
 
 func (s *KanbanServiceImpl) RenameColumn(projectID, columnID, newName, userID string) (*models.KanbanColumn, error) {
@@ -289,47 +499,227 @@ func (s *KanbanServiceImpl) RenameColumn(projectID, columnID, newName, userID st
         return nil, errors.New("forbidden")
     }
 
-    col := &models.KanbanColumn{
-        ID:        columnID,
-        ProjectID: projectID,
-        Name:      newName,
+    col, err := s.kanbanRepo.GetColumnByID(ctx, columnID)
+    if err != nil || col.ProjectID != projectID {
+        return nil, errors.New("column_not_found")
     }
+    oldName := col.Name
 
     err = s.kanbanRepo.UpdateColumnName(ctx, columnID, newName)
     if err != nil {
         return nil, err
     }
+    col.Name = newName
+
+    if oldName != newName {
+        _ = s.activity.LogProject(ctx, projectID, &userID, models.ActivityColumnRenamed, map[string]interface{}{
+            "column_id": columnID,
+            "old":       oldName,
+            "new":       newName,
+        })
+    }
 
     return col, nil
 }
 
-func (s *KanbanServiceImpl) DeleteColumn(projectID, columnID, userID string) error {
+// SetColumnWIPLimit sets or clears (nil) the column's WIP limit. An empty
+// mode keeps the current one.
+func (s *KanbanServiceImpl) SetColumnWIPLimit(projectID, columnID string, limit *int, mode, userID string) (*models.KanbanColumn, error) {
+    ctx := context.Background()
+
+    isMember, err := s.projectMemberRepo.IsMember(ctx, projectID, userID)
+    if err != nil {
+        return nil, err
+    }
+    if !isMember {
+        return nil, errors.New("forbidden")
+    }
+
+    col, err := s.kanbanRepo.GetColumnByID(ctx, columnID)
+    if err != nil || col.ProjectID != projectID {
+        return nil, errors.New("column_not_found")
+    }
+
+    if limit != nil && *limit <= 0 {
+        return nil, models.ErrInvalidWIPLimit
+    }
+    if mode == "" {
+        mode = col.WIPMode
+    }
+    if mode != models.WIPModeSoft && mode != models.WIPModeHard {
+        return nil, models.ErrInvalidWIPMode
+    }
+
+    oldLimit, oldMode := col.WIPLimit, col.WIPMode
+    if err := s.kanbanRepo.UpdateColumnWIPLimit(ctx, columnID, limit, mode); err != nil {
+        return nil, err
+    }
+    col.WIPLimit, col.WIPMode = limit, mode
+
+    _ = s.activity.LogProject(ctx, projectID, &userID, models.ActivityColumnWIPLimitChanged, map[string]interface{}{
+        "column_id": columnID,
+        "name":      col.Name,
+        "old":       map[string]interface{}{"limit": oldLimit, "mode": oldMode},
+        "new":       map[string]interface{}{"limit": limit, "mode": mode},
+    })
+
+    return col, nil
+}
+
+// DeleteColumn removes a column. Its cards are moved to the end of
+// targetColumnID first, in their current order, and returned with their
+// new positions. A target is required as long as the column holds live
+// cards; trashed cards go along with the live ones, or are detached from
+// the board when there is no target. The target's WIP limit applies to
+// the whole batch, and a soft limit it goes past is returned.
+func (s *KanbanServiceImpl) DeleteColumn(projectID, columnID, targetColumnID, userID string) ([]models.Issue, *models.WIPWarning, error) {
     ctx := context.Background()
 
     // Validate membership
     isMember, err := s.projectMemberRepo.IsMember(ctx, projectID, userID)
     if err != nil {
-        return err
+        return nil, nil, err
     }
     if !isMember {
-        return errors.New("forbidden")
+        return nil, nil, errors.New("forbidden")
+    }
+
+    col, err := s.kanbanRepo.GetColumnByID(ctx, columnID)
+    if err != nil || col.ProjectID != projectID {
+        return nil, nil, errors.New("column_not_found")
     }
 
-    // Run inside transaction
-    return s.kanbanRepo.Tx(ctx, func(tx repo.KanbanRepository) error {
+    var target *models.KanbanColumn
+    if targetColumnID != "" {
+        target, err = s.kanbanRepo.GetColumnByID(ctx, targetColumnID)
+        if err != nil || target.ProjectID != projectID || target.ID == columnID {
+            return nil, nil, errors.New("target_column_not_found")
+        }
+    }
+
+    // Run inside transaction. Both columns are locked before their cards
+    // are counted, so no card slips in between the checks and the delete.
+    var moved []string
+    var warning *models.WIPWarning
+    err = s.kanbanRepo.Tx(ctx, func(tx repo.KanbanRepository) error {
 
-        // 1. Delete cards in this column
-        if err := tx.DeleteCardsByColumn(ctx, columnID); err != nil {
+        if _, err := tx.LockColumn(ctx, columnID); err != nil {
+            return errors.New("column_not_found")
+        }
+        count, err := tx.CountCards(ctx, columnID)
+        if err != nil {
             return err
         }
 
-        // 2. Delete column
-        if err := tx.DeleteColumn(ctx, columnID); err != nil {
+        // 1. Move the cards out of the column
+        if target == nil {
+            if count > 0 {
+                return models.ErrColumnTargetRequired
+            }
+            if err := tx.DetachTrashedCards(ctx, columnID); err != nil {
+                return err
+            }
+            return tx.DeleteColumn(ctx, columnID)
+        }
+
+        w, err := admitCards(ctx, tx, target.ID, count, "target_column_not_found")
+        if err != nil {
             return err
         }
+        warning = w
 
-        return nil
+        ids, err := tx.RelocateCards(ctx, columnID, target.ID)
+        if err != nil {
+            return err
+        }
+        moved = ids
+        for _, cardID := range moved {
+            if err := tx.RecordColumnMove(ctx, cardID, columnID, target.ID); err != nil {
+                return err
+            }
+        }
+
+        // 2. Delete column
+        return tx.DeleteColumn(ctx, columnID)
     })
+    if err != nil {
+        return nil, nil, err
+    }
+
+    cards := make([]models.Issue, 0, len(moved))
+    for _, cardID := range moved {
+        card, err := s.kanbanRepo.GetCardByID(ctx, cardID)
+        if err != nil {
+            continue
+        }
+        cards = append(cards, *card)
+
+        _ = s.activity.Log(ctx, cardID, &userID, models.ActivityCardMoved, map[string]interface{}{
+            "from_column_id": columnID,
+            "from_column":    col.Name,
+            "to_column_id":   target.ID,
+            "to_column":      target.Name,
+            "new_order":      card.Order,
+            "reason":         "column_deleted",
+        })
+    }
+
+    meta := map[string]interface{}{
+        "column_id":  columnID,
+        "name":       col.Name,
+        "card_count": len(moved),
+    }
+    if target != nil {
+        meta["target_column_id"] = target.ID
+        meta["target_column"] = target.Name
+    }
+    _ = s.activity.LogProject(ctx, projectID, &userID, models.ActivityColumnDeleted, meta)
+    return cards, warning, nil
+}
+
+// ArchiveColumn hides a column from its board. The cards stay in it and
+// come back with the column when it is unarchived.
+func (s *KanbanServiceImpl) ArchiveColumn(projectID, columnID, userID string) (*models.KanbanColumn, error) {
+    return s.setColumnArchived(projectID, columnID, userID, true)
+}
+
+// UnarchiveColumn puts an archived column back at its old place.
+func (s *KanbanServiceImpl) UnarchiveColumn(projectID, columnID, userID string) (*models.KanbanColumn, error) {
+    return s.setColumnArchived(projectID, columnID, userID, false)
+}
+
+func (s *KanbanServiceImpl) setColumnArchived(projectID, columnID, userID string, archive bool) (*models.KanbanColumn, error) {
+    ctx := context.Background()
+
+    if err := s.ensureMember(ctx, projectID, userID); err != nil {
+        return nil, err
+    }
+
+    col, err := s.kanbanRepo.GetColumnByID(ctx, columnID)
+    if err != nil || col.ProjectID != projectID {
+        return nil, errors.New("column_not_found")
+    }
+    if (col.ArchivedAt != nil) == archive {
+        return col, nil
+    }
+
+    var by *string
+    action := models.ActivityColumnUnarchived
+    if archive {
+        by = &userID
+        action = models.ActivityColumnArchived
+    }
+    if err := s.kanbanRepo.SetColumnArchived(ctx, columnID, by); err != nil {
+        return nil, err
+    }
+
+    count, _ := s.kanbanRepo.CountCards(ctx, columnID)
+    _ = s.activity.LogProject(ctx, projectID, &userID, action, map[string]interface{}{
+        "column_id":  columnID,
+        "name":       col.Name,
+        "card_count": count,
+    })
+    return s.kanbanRepo.GetColumnByID(ctx, columnID)
 }
 
 func (s *KanbanServiceImpl) DeleteCard(cardID, userID string) (*models.Issue, error) {
@@ -353,21 +743,48 @@ func (s *KanbanServiceImpl) DeleteCard(cardID, userID string) (*models.Issue, er
     columnID := card.ColumnID
     oldOrder := card.Order
 
-    // 3. Delete inside transaction
-    err = s.kanbanRepo.Tx(ctx, func(tx repo.KanbanRepository) error {
-
-        // Shift down other cards in the same column
-        if err := tx.ShiftOrdersDown(ctx, columnID, oldOrder); err != nil {
-            return err
-        }
+    // 3. Move the card to the trash; the other cards keep their ranks
+    if err := s.kanbanRepo.DeleteCard(ctx, cardID, userID); err != nil {
+        return nil, err
+    }
 
-        // Delete the card
-        return tx.DeleteCard(ctx, cardID)
+    _ = s.activity.Log(ctx, cardID, &userID, models.ActivityCardDeleted, map[string]interface{}{
+        "column_id": columnID,
+        "column":    s.columnName(ctx, columnID),
+        "order":     oldOrder,
     })
 
-    if err != nil {
+    return card, nil
+}
+
+// admitCards lets n cards into columnID inside tx (see
+// KanbanRepository.AdmitCards). WIP and archive rejections pass through;
+// any other failure to lock the column is reported as notFound.
+func admitCards(ctx context.Context, tx repo.KanbanRepository, columnID string, n int, notFound string) (*models.WIPWarning, error) {
+    w, err := tx.AdmitCards(ctx, columnID, n)
+    if errors.Is(err, models.ErrColumnArchived) || errors.Is(err, models.ErrWIPLimitExceeded) {
         return nil, err
     }
+    if err != nil {
+        return nil, errors.New(notFound)
+    }
+    return w, nil
+}
 
-    return card, nil
+// logCardCreated records a card added from the board.
+func (s *KanbanServiceImpl) logCardCreated(ctx context.Context, card *models.Issue, userID string) {
+    _ = s.activity.Log(ctx, card.ID, &userID, models.ActivityCardCreated, map[string]interface{}{
+        "column_id": card.ColumnID,
+        "column":    s.columnName(ctx, card.ColumnID),
+        "order":     card.Order,
+    })
+}
+
+// columnName is best effort: the log keeps the id when the name is gone.
+func (s *KanbanServiceImpl) columnName(ctx context.Context, columnID string) string {
+    col, err := s.kanbanRepo.GetColumnByID(ctx, columnID)
+    if err != nil {
+        return ""
+    }
+    return col.Name
 }
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+)
+
+//
+// ---------------------------------------------------------------
+// SWIMLANES
+// ---------------------------------------------------------------
+//
+
+// priorityLaneRank puts the most urgent lane on top.
+var priorityLaneRank = map[string]int{
+	"critical": 0, "high": 1, "medium": 2, "low": 3,
+}
+
+// buildSwimlanes splits every column by lane. Lanes are ordered by title
+// (priority by urgency) with the "none" lane last; only lanes holding a
+// card are returned.
+func buildSwimlanes(cols []models.KanbanColumnWithCards, keys map[string]models.LaneKey, group string) []models.Swimlane {
+	lanes := map[string]*models.Swimlane{}
+	var order []string
+
+	for ci, col := range cols {
+		for _, card := range col.Cards {
+			k := keys[card.ID]
+			lane, ok := lanes[k.Key]
+			if !ok {
+				lane = &models.Swimlane{Title: laneTitle(k, group), Cells: make([]models.SwimlaneCell, len(cols))}
+				if k.Key != "" {
+					key := k.Key
+					lane.Key = &key
+				}
+				for n, c := range cols {
+					lane.Cells[n] = models.SwimlaneCell{ColumnID: c.ID, Cards: []models.Issue{}}
+				}
+				lanes[k.Key] = lane
+				order = append(order, k.Key)
+			}
+			cell := &lane.Cells[ci]
+			cell.Cards = append(cell.Cards, card)
+			cell.Count++
+			lane.CardCount++
+		}
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		ka, kb := order[a], order[b]
+		if ka == "" || kb == "" {
+			return kb == ""
+		}
+		if group == models.SwimlanePriority {
+			return priorityLaneRank[ka] < priorityLaneRank[kb]
+		}
+		return strings.ToLower(lanes[ka].Title) < strings.ToLower(lanes[kb].Title)
+	})
+
+	out := make([]models.Swimlane, 0, len(order))
+	for _, k := range order {
+		out = append(out, *lanes[k])
+	}
+	return out
+}
+
+func laneTitle(k models.LaneKey, group string) string {
+	if k.Key != "" {
+		return k.Title
+	}
+	switch group {
+	case models.SwimlaneAssignee:
+		return "Unassigned"
+	case models.SwimlaneEpic:
+		return "No epic"
+	case models.SwimlaneLabel:
+		return "No label"
+	}
+	return "None"
+}
+
+// laneChange is a validated lane move: the issue whose attribute changes
+// and the value it had. That issue is the card itself, except for epic
+// lanes where it is the card's top-most non-epic ancestor, so that a task
+// under a story moves with its story instead of losing it.
+type laneChange struct {
+	issueID string
+	from    *string
+}
+
+// checkLaneMove validates the target lane of a card and returns the change
+// it makes.
+func (s *KanbanServiceImpl) checkLaneMove(ctx context.Context, card *models.Issue, lane *models.LaneMove) (*laneChange, error) {
+	if !models.SwimlaneGroups[lane.Group] {
+		return nil, errors.New("invalid swimlane group")
+	}
+	if lane.Group == models.SwimlaneEpic {
+		return s.checkEpicLaneMove(ctx, card, lane)
+	}
+
+	keys, err := s.kanbanRepo.ListCardLanes(ctx, card.ProjectID, lane.Group, &card.ID)
+	if err != nil {
+		return nil, err
+	}
+	change := &laneChange{issueID: card.ID}
+	if k := keys[card.ID].Key; k != "" {
+		change.from = &k
+	}
+
+	if lane.Key == nil {
+		if lane.Group == models.SwimlanePriority {
+			return nil, errors.New("priority cannot be cleared")
+		}
+		return change, nil
+	}
+
+	switch lane.Group {
+	case models.SwimlaneAssignee:
+		isMember, err := s.projectMemberRepo.IsMember(ctx, card.ProjectID, *lane.Key)
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
+			return nil, errors.New("invalid assignee")
+		}
+	case models.SwimlanePriority:
+		if !validPriorities[*lane.Key] {
+			return nil, ErrInvalidPriority
+		}
+	}
+	return change, nil
+}
+
+// checkEpicLaneMove re-parents the top-most non-epic ancestor of the card
+// (the card itself when its parent is an epic or missing) under the target
+// epic, or detaches it from its epic when lane.Key is nil.
+func (s *KanbanServiceImpl) checkEpicLaneMove(ctx context.Context, card *models.Issue, lane *models.LaneMove) (*laneChange, error) {
+	cur, err := s.issueRepo.GetByID(ctx, card.ID)
+	if err != nil || cur == nil {
+		return nil, errors.New("card_not_found")
+	}
+	if cur.IssueType == models.IssueTypeEpic {
+		return nil, errEpicWithParent
+	}
+
+	if lane.Key != nil {
+		epic, err := s.issueRepo.GetByID(ctx, *lane.Key)
+		if err != nil || epic == nil || epic.ProjectID != card.ProjectID || epic.IssueType != models.IssueTypeEpic {
+			return nil, errors.New("epic not found")
+		}
+	}
+
+	ancestors, err := s.issueRepo.ListAncestors(ctx, card.ID)
+	if err != nil {
+		return nil, err
+	}
+	top := cur
+	for n := range ancestors {
+		if ancestors[n].IssueType == models.IssueTypeEpic {
+			break
+		}
+		top = &ancestors[n]
+	}
+	return &laneChange{issueID: top.ID, from: top.ParentIssueID}, nil
+}
+
+// applyLaneMove runs inside the move transaction.
+func applyLaneMove(ctx context.Context, tx repo.KanbanRepository, card *models.Issue, lane *models.LaneMove, change *laneChange) error {
+	if models.SameID(change.from, lane.Key) {
+		return nil
+	}
+	return tx.ApplyLaneMove(ctx, card.ProjectID, change.issueID, lane.Group, change.from, lane.Key)
+}
+
+// logLaneMove records the attribute change behind a lane move with the
+// activity type an edit of that field would use, on the issue it changed.
+func (s *KanbanServiceImpl) logLaneMove(ctx context.Context, userID string, lane *models.LaneMove, change *laneChange) {
+	from, issueID := change.from, change.issueID
+	if models.SameID(from, lane.Key) {
+		return
+	}
+
+	switch lane.Group {
+	case models.SwimlaneAssignee:
+		_ = s.activity.Log(ctx, issueID, &userID, models.ActivityAssigned, map[string]interface{}{
+			"old": from,
+			"new": lane.Key,
+		})
+	case models.SwimlanePriority:
+		_ = s.activity.Log(ctx, issueID, &userID, models.ActivityPriorityChanged, map[string]interface{}{
+			"old": from,
+			"new": lane.Key,
+		})
+	case models.SwimlaneEpic:
+		_ = s.activity.Log(ctx, issueID, &userID, models.ActivityParentChanged, map[string]interface{}{
+			"old": from,
+			"new": lane.Key,
+		})
+	case models.SwimlaneLabel:
+		meta := map[string]interface{}{"added": []string{}, "removed": []string{}}
+		if lane.Key != nil {
+			meta["added"] = []string{*lane.Key}
+		}
+		if from != nil {
+			meta["removed"] = []string{*from}
+		}
+		_ = s.activity.Log(ctx, issueID, &userID, models.ActivityLabelsChanged, meta)
+	}
+}
@@ -92,10 +92,10 @@ func (s *NotificationServiceImpl) MarkAsRead(notificationID string) error {
 	return s.repo.MarkRead(notificationID)
 }
 
-func (s *NotificationServiceImpl) GetUserNotifications(userID string) ([]iface.NotificationView, error) {
-	ns, err := s.repo.GetByUser(userID)
+func (s *NotificationServiceImpl) GetUserNotifications(userID string, page models.PageRequest) ([]iface.NotificationView, models.PageInfo, error) {
+	ns, info, err := s.repo.GetByUser(userID, page)
 	if err != nil {
-		return nil, err
+		return nil, models.PageInfo{}, err
 	}
 
 	out := make([]iface.NotificationView, 0, len(ns))
@@ -112,7 +112,7 @@ func (s *NotificationServiceImpl) GetUserNotifications(userID string) ([]iface.N
 		})
 	}
 
-	return out, nil
+	return out, info, nil
 }
 
 func (s *NotificationServiceImpl) MarkAllAsRead(userID string) error {
@@ -0,0 +1,441 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	service "bugforge-backend/internal/service/interfaces"
+	ws "bugforge-backend/internal/websocket"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type PortfolioServiceImpl struct {
+	portfolioRepo     repo.PortfolioRepository
+	projectRepo       repo.ProjectRepository
+	projectMemberRepo repo.ProjectMemberRepository
+	kanbanRepo        repo.KanbanRepository
+	kanban            *KanbanServiceImpl
+	hub               *ws.Hub
+}
+
+func NewPortfolioService(
+	portfolioRepo repo.PortfolioRepository,
+	projectRepo repo.ProjectRepository,
+	projectMemberRepo repo.ProjectMemberRepository,
+	kanbanRepo repo.KanbanRepository,
+	kanban *KanbanServiceImpl,
+	hub *ws.Hub,
+) service.PortfolioService {
+	return &PortfolioServiceImpl{
+		portfolioRepo:     portfolioRepo,
+		projectRepo:       projectRepo,
+		projectMemberRepo: projectMemberRepo,
+		kanbanRepo:        kanbanRepo,
+		kanban:            kanban,
+		hub:               hub,
+	}
+}
+
+func (s *PortfolioServiceImpl) CreatePortfolio(ctx context.Context, customerID string, p *models.Portfolio, userID string) (*models.Portfolio, error) {
+	p.ID = uuid.NewString()
+	p.CustomerID = customerID
+	p.CreatedBy = userID
+
+	if err := s.checkPortfolio(ctx, p, nil, userID); err != nil {
+		return nil, err
+	}
+	if err := s.portfolioRepo.CreatePortfolio(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// UpdatePortfolio replaces the name, the projects and the columns with
+// their mappings. Columns sent with the id of an existing column keep it.
+func (s *PortfolioServiceImpl) UpdatePortfolio(ctx context.Context, customerID, portfolioID string, p *models.Portfolio, userID string) (*models.Portfolio, error) {
+	cur, err := s.GetPortfolio(ctx, customerID, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureMemberOfAll(ctx, cur.ProjectIDs, userID); err != nil {
+		return nil, err
+	}
+
+	cur.Name = p.Name
+	cur.ProjectIDs = p.ProjectIDs
+	existing := cur.Columns
+	cur.Columns = p.Columns
+	if err := s.checkPortfolio(ctx, cur, existing, userID); err != nil {
+		return nil, err
+	}
+	if err := s.portfolioRepo.UpdatePortfolio(ctx, cur); err != nil {
+		return nil, err
+	}
+
+	if s.hub != nil {
+		b, _ := json.Marshal(map[string]any{
+			"type":      "portfolio_updated",
+			"portfolio": cur,
+		})
+		s.hub.BroadcastPortfolio(cur.ID, b)
+	}
+	return cur, nil
+}
+
+func (s *PortfolioServiceImpl) DeletePortfolio(ctx context.Context, customerID, portfolioID, userID string) error {
+	cur, err := s.GetPortfolio(ctx, customerID, portfolioID)
+	if err != nil {
+		return err
+	}
+	if err := s.ensureMemberOfAll(ctx, cur.ProjectIDs, userID); err != nil {
+		return err
+	}
+	return s.portfolioRepo.DeletePortfolio(ctx, portfolioID)
+}
+
+func (s *PortfolioServiceImpl) GetPortfolio(ctx context.Context, customerID, portfolioID string) (*models.Portfolio, error) {
+	p, err := s.portfolioRepo.GetPortfolioByID(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil || p.CustomerID != customerID {
+		return nil, models.ErrPortfolioNotFound
+	}
+	return p, nil
+}
+
+func (s *PortfolioServiceImpl) ListPortfolios(ctx context.Context, customerID string) ([]models.Portfolio, error) {
+	return s.portfolioRepo.ListPortfolios(ctx, customerID)
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   BOARD
+// ─────────────────────────────────────────────────────────────
+//
+
+func (s *PortfolioServiceImpl) GetBoard(ctx context.Context, customerID, portfolioID, userID string) (*models.PortfolioBoard, error) {
+	p, err := s.GetPortfolio(ctx, customerID, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	visible, err := s.visibleProjects(ctx, p, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	board := &models.PortfolioBoard{
+		Portfolio: p,
+		Projects:  []models.PortfolioProject{},
+		Columns:   make([]models.PortfolioColumnWithCards, 0, len(p.Columns)),
+	}
+	ids := make([]string, 0, len(visible))
+	for _, pr := range visible {
+		board.Projects = append(board.Projects, models.PortfolioProject{ID: pr.ID, Name: pr.Name, Key: pr.Slug})
+		ids = append(ids, pr.ID)
+	}
+
+	cards := map[string][]models.PortfolioCard{}
+	if len(ids) > 0 {
+		if cards, err = s.portfolioRepo.ListPortfolioCards(ctx, p.ID, ids); err != nil {
+			return nil, err
+		}
+	}
+	for _, col := range p.Columns {
+		colCards := cards[col.ID]
+		if colCards == nil {
+			colCards = []models.PortfolioCard{}
+		}
+		board.Columns = append(board.Columns, models.PortfolioColumnWithCards{
+			ID:        col.ID,
+			Name:      col.Name,
+			Order:     col.Order,
+			CardCount: len(colCards),
+			Cards:     colCards,
+		})
+	}
+	return board, nil
+}
+
+// MoveCard translates a drop into a portfolio column back into the card's
+// project: the card moves to the project column mapped into it, preferring
+// one on the board the card is on. The move itself goes through the kanban
+// service, so WIP limits, ranks and the activity log apply as usual.
+func (s *PortfolioServiceImpl) MoveCard(ctx context.Context, customerID, portfolioID, cardID, portfolioColumnID string, order int, userID string) (*models.Issue, error) {
+	p, err := s.GetPortfolio(ctx, customerID, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	var target *models.PortfolioColumn
+	for n := range p.Columns {
+		if p.Columns[n].ID == portfolioColumnID {
+			target = &p.Columns[n]
+		}
+	}
+	if target == nil {
+		return nil, errors.New("portfolio column not found")
+	}
+
+	card, err := s.kanbanRepo.GetCardByID(ctx, cardID)
+	if err != nil || !containsString(p.ProjectIDs, card.ProjectID) {
+		return nil, errors.New("card_not_found")
+	}
+
+	toColumnID, err := s.mappedColumn(ctx, card, target)
+	if err != nil {
+		return nil, err
+	}
+
+	pos, err := s.dropPosition(ctx, p, target.ID, card, toColumnID, order, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	moved, fromColumnID, warning, err := s.kanban.MoveCard(cardID, toColumnID, pos, nil, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.broadcastMove(moved, fromColumnID, warning)
+	return moved, nil
+}
+
+// mappedColumn picks the column of the card's project mapped into target.
+func (s *PortfolioServiceImpl) mappedColumn(ctx context.Context, card *models.Issue, target *models.PortfolioColumn) (string, error) {
+	if containsString(target.ColumnIDs, card.ColumnID) {
+		return card.ColumnID, nil
+	}
+
+	cols, err := s.portfolioRepo.ListProjectColumns(ctx, []string{card.ProjectID})
+	if err != nil {
+		return "", err
+	}
+	currentBoard := s.kanban.BoardOfColumn(card.ColumnID)
+
+	found := ""
+	for _, col := range cols {
+		if col.ArchivedAt != nil || !containsString(target.ColumnIDs, col.ID) {
+			continue
+		}
+		if col.BoardID == currentBoard {
+			return col.ID, nil
+		}
+		if found == "" {
+			found = col.ID
+		}
+	}
+	if found == "" {
+		return "", models.ErrPortfolioNoMapping
+	}
+	return found, nil
+}
+
+// dropPosition translates a drop at the 1-based index order of a portfolio
+// column, as the viewer sees it, into a position in the project column
+// toColumnID: the card lands after the cards of that column shown above the
+// drop point. order <= 0 drops at the end.
+func (s *PortfolioServiceImpl) dropPosition(ctx context.Context, p *models.Portfolio, portfolioColumnID string, card *models.Issue, toColumnID string, order int, userID string) (int, error) {
+	visible, err := s.visibleProjects(ctx, p, userID)
+	if err != nil {
+		return 0, err
+	}
+	ids := make([]string, 0, len(visible))
+	for _, pr := range visible {
+		ids = append(ids, pr.ID)
+	}
+	cards, err := s.portfolioRepo.ListPortfolioCards(ctx, p.ID, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	pos, n := 1, 0
+	for _, c := range cards[portfolioColumnID] {
+		if c.ID == card.ID {
+			continue
+		}
+		n++
+		if order > 0 && n >= order {
+			break
+		}
+		if c.ColumnID == toColumnID {
+			pos++
+		}
+	}
+	return pos, nil
+}
+
+// broadcastMove tells the project boards about a move made from a
+// portfolio; the portfolio rooms get it forwarded from there.
+func (s *PortfolioServiceImpl) broadcastMove(card *models.Issue, fromColumnID string, warning *models.WIPWarning) {
+	if s.hub == nil {
+		return
+	}
+
+	b, _ := json.Marshal(map[string]any{
+		"type":      "card_moved",
+		"projectID": card.ProjectID,
+		"payload": map[string]any{
+			"card_id":     card.ID,
+			"from_column": fromColumnID,
+			"to_column":   card.ColumnID,
+			"new_order":   card.Order,
+		},
+	})
+	s.hub.BroadcastProject(card.ProjectID, b)
+
+	if warning != nil {
+		b, _ := json.Marshal(map[string]any{
+			"type":      "wip_limit_exceeded",
+			"projectID": card.ProjectID,
+			"payload": map[string]any{
+				"card_id": card.ID,
+				"warning": warning,
+			},
+		})
+		s.hub.GetRoom(card.ProjectID, s.kanban.BoardOfColumn(card.ColumnID)).Broadcast(b)
+	}
+}
+
+func (s *PortfolioServiceImpl) ViewerProjects(ctx context.Context, customerID, portfolioID, userID string) ([]string, error) {
+	p, err := s.GetPortfolio(ctx, customerID, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	visible, err := s.visibleProjects(ctx, p, userID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(visible))
+	for _, pr := range visible {
+		ids = append(ids, pr.ID)
+	}
+	return ids, nil
+}
+
+// visibleProjects returns the live portfolio projects the user is a member of.
+func (s *PortfolioServiceImpl) visibleProjects(ctx context.Context, p *models.Portfolio, userID string) ([]models.Project, error) {
+	out := []models.Project{}
+	for _, id := range p.ProjectIDs {
+		pr, err := s.projectRepo.GetByID(ctx, id, p.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+		if pr == nil {
+			continue
+		}
+		isMember, err := s.projectMemberRepo.IsMember(ctx, id, userID)
+		if err != nil {
+			return nil, err
+		}
+		if isMember {
+			out = append(out, *pr)
+		}
+	}
+	return out, nil
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   VALIDATION
+// ─────────────────────────────────────────────────────────────
+//
+
+// checkPortfolio validates a portfolio definition before it is written.
+// The user must be a member of every project; every mapped column must
+// belong to one of them and be mapped once. existing holds the columns of
+// the portfolio being edited, whose ids may be reused.
+func (s *PortfolioServiceImpl) checkPortfolio(ctx context.Context, p *models.Portfolio, existing []models.PortfolioColumn, userID string) error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return errors.New("name is required")
+	}
+	others, err := s.portfolioRepo.ListPortfolios(ctx, p.CustomerID)
+	if err != nil {
+		return err
+	}
+	for _, o := range others {
+		if o.ID != p.ID && strings.EqualFold(o.Name, p.Name) {
+			return errors.New("a portfolio with this name already exists")
+		}
+	}
+
+	projectIDs := []string{}
+	for _, id := range p.ProjectIDs {
+		if !containsString(projectIDs, id) {
+			projectIDs = append(projectIDs, id)
+		}
+	}
+	if len(projectIDs) == 0 {
+		return errors.New("project_ids is required")
+	}
+	for _, id := range projectIDs {
+		pr, err := s.projectRepo.GetByID(ctx, id, p.CustomerID)
+		if err != nil || pr == nil {
+			return errors.New("project not found: " + id)
+		}
+	}
+	if err := s.ensureMemberOfAll(ctx, projectIDs, userID); err != nil {
+		return err
+	}
+	p.ProjectIDs = projectIDs
+
+	if len(p.Columns) == 0 {
+		return errors.New("at least one column is required")
+	}
+	projectCols, err := s.portfolioRepo.ListProjectColumns(ctx, projectIDs)
+	if err != nil {
+		return err
+	}
+	known := map[string]bool{}
+	for _, c := range projectCols {
+		known[c.ID] = true
+	}
+	reusable := map[string]bool{}
+	for _, c := range existing {
+		reusable[c.ID] = true
+	}
+
+	mapped := map[string]bool{}
+	for n := range p.Columns {
+		col := &p.Columns[n]
+		col.Name = strings.TrimSpace(col.Name)
+		if col.Name == "" {
+			return errors.New("column name is required")
+		}
+		if !reusable[col.ID] {
+			col.ID = uuid.NewString()
+		}
+		delete(reusable, col.ID)
+
+		if col.ColumnIDs == nil {
+			col.ColumnIDs = []string{}
+		}
+		for _, id := range col.ColumnIDs {
+			if !known[id] {
+				return errors.New("column does not belong to a portfolio project: " + id)
+			}
+			if mapped[id] {
+				return errors.New("column is mapped twice: " + id)
+			}
+			mapped[id] = true
+		}
+	}
+	return nil
+}
+
+func (s *PortfolioServiceImpl) ensureMemberOfAll(ctx context.Context, projectIDs []string, userID string) error {
+	for _, id := range projectIDs {
+		isMember, err := s.projectMemberRepo.IsMember(ctx, id, userID)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			return errors.New("forbidden")
+		}
+	}
+	return nil
+}
@@ -54,8 +54,8 @@ func (s *ProjectMemberServiceImpl) RemoveMember(ctx context.Context, projectID,
 	return s.memberRepo.RemoveMember(ctx, projectID, userID)
 }
 
-func (s *ProjectMemberServiceImpl) ListMembers(ctx context.Context, projectID, customerID string) ([]models.User, error) {
-	return s.memberRepo.ListMembers(ctx, projectID, customerID)
+func (s *ProjectMemberServiceImpl) ListMembers(ctx context.Context, projectID, customerID string, page models.PageRequest) ([]models.User, models.PageInfo, error) {
+	return s.memberRepo.ListMembers(ctx, projectID, customerID, page)
 }
 
 func (s *ProjectMemberServiceImpl) Invite(
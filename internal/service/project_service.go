@@ -128,16 +128,51 @@ func (s *ProjectServiceImpl) UpdateProject(ctx context.Context, id, customerID,
 	return proj, nil
 }
 
-func (s *ProjectServiceImpl) DeleteProject(ctx context.Context, id, customerID string) error {
+func (s *ProjectServiceImpl) DeleteProject(ctx context.Context, id, customerID, userID string) error {
 	proj, err := s.projectRepo.GetByID(ctx, id, customerID)
 	if err != nil {
 		return err
 	}
 	if proj == nil {
-		return errors.New("project not found")
+		return models.ErrProjectNotFound
 	}
 
-	return s.projectRepo.Delete(ctx, id, customerID)
+	return s.projectRepo.Delete(ctx, id, customerID, userID)
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   TRASH
+// ─────────────────────────────────────────────────────────────
+//
+
+func (s *ProjectServiceImpl) ListDeletedProjects(ctx context.Context, customerID string) ([]models.Project, error) {
+	return s.projectRepo.ListDeleted(ctx, customerID)
+}
+
+func (s *ProjectServiceImpl) RestoreProject(ctx context.Context, id, customerID string) (*models.Project, error) {
+	proj, err := s.projectRepo.GetDeletedByID(ctx, id, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if proj == nil {
+		return nil, models.ErrNotInTrash
+	}
+
+	// The slug may have been reused while the project was in the trash.
+	existing, err := s.projectRepo.GetBySlug(ctx, proj.Slug, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.New("slug already exists for this customer; rename the other project first")
+	}
+
+	if err := s.projectRepo.Restore(ctx, id, customerID); err != nil {
+		return nil, err
+	}
+
+	return s.projectRepo.GetByID(ctx, id, customerID)
 }
 
 //
@@ -146,11 +181,11 @@ func (s *ProjectServiceImpl) DeleteProject(ctx context.Context, id, customerID s
 // ─────────────────────────────────────────────────────────────
 //
 
-func (s *ProjectServiceImpl) ListProjectActivity(ctx context.Context, customerID, projectID string) ([]models.IssueActivity, error) {
+func (s *ProjectServiceImpl) ListProjectActivity(ctx context.Context, customerID, projectID string, page models.PageRequest) ([]models.IssueActivity, models.PageInfo, error) {
 
 	if err := s.ensureProjectAndTenant(ctx, customerID, projectID); err != nil {
-		return nil, err
+		return nil, models.PageInfo{}, err
 	}
 
-	return s.activityRepo.ListByProject(ctx, projectID)
+	return s.activityRepo.ListByProject(ctx, projectID, page)
 }
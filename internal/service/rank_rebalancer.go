@@ -0,0 +1,70 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	"context"
+	"log"
+	"time"
+)
+
+const rankRebalanceInterval = 10 * time.Minute
+
+// RankRebalancer respaces kanban ranks that have grown past
+// models.RankMaxLength, which happens when cards keep being dropped into
+// the same gap. Rebalancing rewrites a whole column, so it runs in the
+// background instead of on the move path.
+type RankRebalancer struct {
+	kanbanRepo repo.KanbanRepository
+}
+
+func NewRankRebalancer(kanbanRepo repo.KanbanRepository) *RankRebalancer {
+	return &RankRebalancer{kanbanRepo: kanbanRepo}
+}
+
+// Run rebalances once immediately and then every ten minutes until ctx is
+// cancelled.
+func (b *RankRebalancer) Run(ctx context.Context) {
+	ticker := time.NewTicker(rankRebalanceInterval)
+	defer ticker.Stop()
+
+	for {
+		b.RebalanceOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (b *RankRebalancer) RebalanceOnce(ctx context.Context) {
+	columnIDs, boardIDs, err := b.kanbanRepo.ListLongRanks(ctx, models.RankMaxLength)
+	if err != nil {
+		log.Println("rank rebalance:", err)
+		return
+	}
+
+	for _, columnID := range columnIDs {
+		err := b.kanbanRepo.Tx(ctx, func(tx repo.KanbanRepository) error {
+			return tx.RebalanceCards(ctx, columnID)
+		})
+		if err != nil {
+			log.Println("rank rebalance (column "+columnID+"):", err)
+		}
+	}
+
+	for _, boardID := range boardIDs {
+		err := b.kanbanRepo.Tx(ctx, func(tx repo.KanbanRepository) error {
+			return tx.RebalanceColumns(ctx, boardID)
+		})
+		if err != nil {
+			log.Println("rank rebalance (board "+boardID+"):", err)
+		}
+	}
+
+	if n := len(columnIDs) + len(boardIDs); n > 0 {
+		log.Printf("rank rebalance: respaced %d card lists and %d column lists", len(columnIDs), len(boardIDs))
+	}
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+)
+
+//
+// ─────────────────────────────────────────────────────────────
+//   CHANGELOG
+// ─────────────────────────────────────────────────────────────
+//
+
+// changelogTypes fixes the section order and headings.
+var changelogTypes = []struct{ issueType, heading string }{
+	{models.IssueTypeEpic, "Epics"},
+	{models.IssueTypeStory, "Features"},
+	{models.IssueTypeTask, "Improvements"},
+	{models.IssueTypeBug, "Bug fixes"},
+}
+
+const unlabelledHeading = "Other"
+
+func (s *ReleaseServiceImpl) GetChangelog(ctx context.Context, customerID, projectID, releaseID string) (*models.Changelog, error) {
+	r, err := s.GetRelease(ctx, customerID, projectID, releaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.releaseRepo.ListChangelogEntries(ctx, releaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildChangelog(r, entries), nil
+}
+
+func (s *ReleaseServiceImpl) RenderChangelog(ctx context.Context, customerID, projectID, releaseID, format string) (string, string, error) {
+	cl, err := s.GetChangelog(ctx, customerID, projectID, releaseID)
+	if err != nil {
+		return "", "", err
+	}
+
+	switch format {
+	case "", "markdown", "md":
+		return changelogMarkdown(cl), "text/markdown; charset=utf-8", nil
+	case "html":
+		return changelogHTML(cl), "text/html; charset=utf-8", nil
+	default:
+		return "", "", errors.New("format must be markdown, html or json")
+	}
+}
+
+// buildChangelog groups entries by type, then by their first label (labels
+// are sorted by name), so that every issue is listed once; the renderers
+// show its other labels next to it. Labelled groups are sorted by name;
+// unlabelled issues come last.
+func buildChangelog(r *models.Release, entries []models.ChangelogEntry) *models.Changelog {
+	byType := map[string]map[string][]models.ChangelogEntry{}
+	for _, e := range entries {
+		label := ""
+		if len(e.Labels) > 0 {
+			label = e.Labels[0]
+		}
+		if byType[e.IssueType] == nil {
+			byType[e.IssueType] = map[string][]models.ChangelogEntry{}
+		}
+		byType[e.IssueType][label] = append(byType[e.IssueType][label], e)
+	}
+
+	cl := &models.Changelog{Release: r, Sections: []models.ChangelogSection{}}
+	for _, t := range changelogTypes {
+		groups := byType[t.issueType]
+		if len(groups) == 0 {
+			continue
+		}
+
+		labels := make([]string, 0, len(groups))
+		for l := range groups {
+			if l != "" {
+				labels = append(labels, l)
+			}
+		}
+		sort.Strings(labels)
+		if _, ok := groups[""]; ok {
+			labels = append(labels, "")
+		}
+
+		section := models.ChangelogSection{IssueType: t.issueType}
+		for _, l := range labels {
+			section.Groups = append(section.Groups, models.ChangelogGroup{Label: l, Entries: groups[l]})
+		}
+		cl.Sections = append(cl.Sections, section)
+	}
+
+	return cl
+}
+
+func changelogHeading(issueType string) string {
+	for _, t := range changelogTypes {
+		if t.issueType == issueType {
+			return t.heading
+		}
+	}
+	return issueType
+}
+
+func changelogLabel(label string) string {
+	if label == "" {
+		return unlabelledHeading
+	}
+	return label
+}
+
+// releaseDateLine describes when the release shipped or is due.
+func releaseDateLine(r *models.Release) string {
+	switch {
+	case r.Released && r.ReleasedAt != nil:
+		return "Released " + r.ReleasedAt.Format("2006-01-02")
+	case r.TargetDate != nil:
+		return "Target date " + r.TargetDate.Format("2006-01-02")
+	default:
+		return "Unreleased"
+	}
+}
+
+// markdownEscaper backslash-escapes the characters that would turn a title
+// into Markdown markup, and folds line breaks that would end a list item.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`,
+	`(`, `\(`, `)`, `\)`, `#`, `\#`, `<`, `\<`, `>`, `\>`, `|`, `\|`,
+	`~`, `\~`, `!`, `\!`, "\r\n", " ", "\n", " ", "\r", " ",
+)
+
+// otherLabels returns the labels of e besides the one it is grouped under.
+func otherLabels(e models.ChangelogEntry) []string {
+	if len(e.Labels) < 2 {
+		return nil
+	}
+	return e.Labels[1:]
+}
+
+func changelogMarkdown(cl *models.Changelog) string {
+	var b strings.Builder
+	md := markdownEscaper.Replace
+
+	fmt.Fprintf(&b, "# %s\n\n_%s_\n", md(cl.Release.Name), releaseDateLine(cl.Release))
+	if d := strings.TrimSpace(cl.Release.Description); d != "" {
+		fmt.Fprintf(&b, "\n%s\n", d)
+	}
+	if len(cl.Sections) == 0 {
+		b.WriteString("\nNo resolved issues yet.\n")
+	}
+
+	for _, sec := range cl.Sections {
+		fmt.Fprintf(&b, "\n## %s\n", changelogHeading(sec.IssueType))
+		for _, g := range sec.Groups {
+			fmt.Fprintf(&b, "\n### %s\n\n", md(changelogLabel(g.Label)))
+			for _, e := range g.Entries {
+				fmt.Fprintf(&b, "- %s", md(e.Title))
+				if more := otherLabels(e); len(more) > 0 {
+					fmt.Fprintf(&b, " (also %s)", md(strings.Join(more, ", ")))
+				}
+				b.WriteString("\n")
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func changelogHTML(cl *models.Changelog) string {
+	var b strings.Builder
+	esc := html.EscapeString
+
+	fmt.Fprintf(&b, "<h1>%s</h1>\n<p><em>%s</em></p>\n", esc(cl.Release.Name), esc(releaseDateLine(cl.Release)))
+	if d := strings.TrimSpace(cl.Release.Description); d != "" {
+		fmt.Fprintf(&b, "<p>%s</p>\n", esc(d))
+	}
+	if len(cl.Sections) == 0 {
+		b.WriteString("<p>No resolved issues yet.</p>\n")
+	}
+
+	for _, sec := range cl.Sections {
+		fmt.Fprintf(&b, "<h2>%s</h2>\n", esc(changelogHeading(sec.IssueType)))
+		for _, g := range sec.Groups {
+			fmt.Fprintf(&b, "<h3>%s</h3>\n<ul>\n", esc(changelogLabel(g.Label)))
+			for _, e := range g.Entries {
+				fmt.Fprintf(&b, "  <li>%s", esc(e.Title))
+				if more := otherLabels(e); len(more) > 0 {
+					fmt.Fprintf(&b, " (also %s)", esc(strings.Join(more, ", ")))
+				}
+				b.WriteString("</li>\n")
+			}
+			b.WriteString("</ul>\n")
+		}
+	}
+
+	return b.String()
+}
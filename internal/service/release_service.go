@@ -0,0 +1,162 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	service "bugforge-backend/internal/service/interfaces"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type ReleaseServiceImpl struct {
+	releaseRepo repo.ReleaseRepository
+	issueRepo   repo.IssueRepository
+	projectRepo repo.ProjectRepository
+	activity    service.ActivityService
+}
+
+func NewReleaseService(
+	releaseRepo repo.ReleaseRepository,
+	issueRepo repo.IssueRepository,
+	projectRepo repo.ProjectRepository,
+	activitySvc service.ActivityService,
+) service.ReleaseService {
+	return &ReleaseServiceImpl{
+		releaseRepo: releaseRepo,
+		issueRepo:   issueRepo,
+		projectRepo: projectRepo,
+		activity:    activitySvc,
+	}
+}
+
+func (s *ReleaseServiceImpl) CreateRelease(ctx context.Context, customerID, projectID string, r *models.Release, userID string) (*models.Release, error) {
+	if err := s.ensureProject(ctx, customerID, projectID); err != nil {
+		return nil, err
+	}
+
+	r.ID = uuid.NewString()
+	r.CustomerID = customerID
+	r.ProjectID = projectID
+	r.CreatedBy = userID
+
+	r.Name = strings.TrimSpace(r.Name)
+	if r.Name == "" {
+		return nil, errors.New("name is required")
+	}
+
+	if err := s.releaseRepo.CreateRelease(ctx, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// UpdateRelease replaces name, description, target date and the released
+// flag.
+func (s *ReleaseServiceImpl) UpdateRelease(ctx context.Context, customerID, projectID, releaseID string, r *models.Release, userID string) (*models.Release, error) {
+	cur, err := s.GetRelease(ctx, customerID, projectID, releaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	cur.Name = strings.TrimSpace(r.Name)
+	if cur.Name == "" {
+		return nil, errors.New("name is required")
+	}
+	cur.Description = r.Description
+	cur.TargetDate = r.TargetDate
+	cur.Released = r.Released
+
+	if err := s.releaseRepo.UpdateRelease(ctx, cur); err != nil {
+		return nil, err
+	}
+	return cur, nil
+}
+
+func (s *ReleaseServiceImpl) DeleteRelease(ctx context.Context, customerID, projectID, releaseID, userID string) error {
+	if _, err := s.GetRelease(ctx, customerID, projectID, releaseID); err != nil {
+		return err
+	}
+	return s.releaseRepo.DeleteRelease(ctx, releaseID)
+}
+
+func (s *ReleaseServiceImpl) GetRelease(ctx context.Context, customerID, projectID, releaseID string) (*models.Release, error) {
+	r, err := s.releaseRepo.GetReleaseByID(ctx, releaseID)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil || r.ProjectID != projectID || r.CustomerID != customerID {
+		return nil, models.ErrReleaseNotFound
+	}
+	return r, nil
+}
+
+func (s *ReleaseServiceImpl) ListReleases(ctx context.Context, customerID, projectID string) ([]models.Release, error) {
+	if err := s.ensureProject(ctx, customerID, projectID); err != nil {
+		return nil, err
+	}
+	return s.releaseRepo.ListReleasesByProject(ctx, projectID)
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   FIX VERSIONS
+// ─────────────────────────────────────────────────────────────
+//
+
+// AddIssues sets the release as fix version of the issues and returns how
+// many of them changed.
+func (s *ReleaseServiceImpl) AddIssues(ctx context.Context, customerID, projectID, releaseID string, issueIDs []string, userID string) (int, error) {
+	if len(issueIDs) == 0 {
+		return 0, errors.New("issue_ids is required")
+	}
+	if _, err := s.GetRelease(ctx, customerID, projectID, releaseID); err != nil {
+		return 0, err
+	}
+
+	changed, err := s.releaseRepo.SetFixVersion(ctx, projectID, &releaseID, issueIDs)
+	if err != nil {
+		return 0, err
+	}
+	for issueID, old := range changed {
+		_ = s.activity.Log(ctx, issueID, &userID, models.ActivityFixVersionChanged, map[string]interface{}{
+			"old": old,
+			"new": releaseID,
+		})
+	}
+	return len(changed), nil
+}
+
+func (s *ReleaseServiceImpl) RemoveIssue(ctx context.Context, customerID, projectID, releaseID, issueID, userID string) error {
+	if _, err := s.GetRelease(ctx, customerID, projectID, releaseID); err != nil {
+		return err
+	}
+
+	iss, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil || iss == nil || iss.ProjectID != projectID {
+		return errors.New("issue not found")
+	}
+	if iss.FixVersionID == nil || *iss.FixVersionID != releaseID {
+		return errors.New("issue is not in this release")
+	}
+
+	if _, err := s.releaseRepo.SetFixVersion(ctx, projectID, nil, []string{issueID}); err != nil {
+		return err
+	}
+
+	_ = s.activity.Log(ctx, issueID, &userID, models.ActivityFixVersionChanged, map[string]interface{}{
+		"old": releaseID,
+		"new": nil,
+	})
+	return nil
+}
+
+func (s *ReleaseServiceImpl) ensureProject(ctx context.Context, customerID, projectID string) error {
+	pr, err := s.projectRepo.GetByID(ctx, projectID, customerID)
+	if err != nil || pr == nil {
+		return errors.New("project not found")
+	}
+	return nil
+}
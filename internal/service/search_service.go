@@ -0,0 +1,93 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	service "bugforge-backend/internal/service/interfaces"
+	"context"
+	"fmt"
+	"strings"
+)
+
+type SearchServiceImpl struct {
+	searchRepo  repo.SearchRepository
+	projectRepo repo.ProjectRepository
+}
+
+func NewSearchService(searchRepo repo.SearchRepository, projectRepo repo.ProjectRepository) service.SearchService {
+	return &SearchServiceImpl{
+		searchRepo:  searchRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+var validSearchTypes = map[string]bool{
+	models.SearchTypeIssue:   true,
+	models.SearchTypeComment: true,
+	models.SearchTypeProject: true,
+	models.SearchTypeUser:    true,
+}
+
+var defaultSearchTypes = []string{
+	models.SearchTypeIssue,
+	models.SearchTypeComment,
+	models.SearchTypeProject,
+	models.SearchTypeUser,
+}
+
+func (s *SearchServiceImpl) Search(
+	ctx context.Context,
+	customerID, text string,
+	types []string,
+	projectID *string,
+	limit int,
+) ([]models.SearchResult, error) {
+
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, invalidSearch("search query is required")
+	}
+
+	if len(types) == 0 {
+		types = defaultSearchTypes
+	}
+	for _, t := range types {
+		if !validSearchTypes[t] {
+			return nil, invalidSearch("unknown type %q", t)
+		}
+	}
+
+	if projectID != nil {
+		pr, err := s.projectRepo.GetByID(ctx, *projectID, customerID)
+		if err != nil {
+			return nil, err
+		}
+		if pr == nil {
+			return nil, invalidSearch("project not found")
+		}
+	}
+
+	// No limit means the default; larger ones are capped.
+	switch {
+	case limit <= 0:
+		limit = defaultSearchLimit
+	case limit > maxSearchLimit:
+		limit = maxSearchLimit
+	}
+
+	return s.searchRepo.Search(ctx, customerID, models.SearchQuery{
+		Text:      text,
+		Types:     types,
+		ProjectID: projectID,
+		Limit:     limit,
+	})
+}
+
+func invalidSearch(format string, args ...any) error {
+	return fmt.Errorf("%w: "+format, append([]any{models.ErrInvalidSearch}, args...)...)
+}
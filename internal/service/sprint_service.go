@@ -0,0 +1,318 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	service "bugforge-backend/internal/service/interfaces"
+	ws "bugforge-backend/internal/websocket"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SprintServiceImpl struct {
+	sprintRepo  repo.SprintRepository
+	projectRepo repo.ProjectRepository
+	activity    service.ActivityService
+	board       boardNotifier
+}
+
+func NewSprintService(
+	sprintRepo repo.SprintRepository,
+	issueRepo repo.IssueRepository,
+	projectRepo repo.ProjectRepository,
+	activitySvc service.ActivityService,
+	hub *ws.Hub,
+) service.SprintService {
+	return &SprintServiceImpl{
+		sprintRepo:  sprintRepo,
+		projectRepo: projectRepo,
+		activity:    activitySvc,
+		board:       boardNotifier{issueRepo: issueRepo, hub: hub},
+	}
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   SPRINT CRUD
+// ─────────────────────────────────────────────────────────────
+//
+
+func (s *SprintServiceImpl) CreateSprint(ctx context.Context, customerID, projectID string, sp *models.Sprint, userID string) (*models.Sprint, error) {
+	if err := s.ensureProject(ctx, customerID, projectID); err != nil {
+		return nil, err
+	}
+
+	sp.ID = uuid.NewString()
+	sp.CustomerID = customerID
+	sp.ProjectID = projectID
+	sp.CreatedBy = userID
+
+	if err := validateSprint(sp); err != nil {
+		return nil, err
+	}
+
+	if err := s.sprintRepo.CreateSprint(ctx, sp); err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
+
+// UpdateSprint replaces name, goal and dates. Closed sprints are frozen.
+func (s *SprintServiceImpl) UpdateSprint(ctx context.Context, customerID, projectID, sprintID string, sp *models.Sprint, userID string) (*models.Sprint, error) {
+	cur, err := s.GetSprint(ctx, customerID, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	if cur.State == models.SprintClosed {
+		return nil, errors.New("closed sprints cannot be edited")
+	}
+
+	cur.Name = sp.Name
+	cur.Goal = sp.Goal
+	cur.StartDate = sp.StartDate
+	cur.EndDate = sp.EndDate
+
+	if err := validateSprint(cur); err != nil {
+		return nil, err
+	}
+
+	if err := s.sprintRepo.UpdateSprint(ctx, cur); err != nil {
+		return nil, err
+	}
+	return cur, nil
+}
+
+// DeleteSprint removes a planned sprint; its issues return to the backlog.
+func (s *SprintServiceImpl) DeleteSprint(ctx context.Context, customerID, projectID, sprintID, userID string) error {
+	cur, err := s.GetSprint(ctx, customerID, projectID, sprintID)
+	if err != nil {
+		return err
+	}
+	if cur.State != models.SprintPlanned {
+		return errors.New("only planned sprints can be deleted")
+	}
+	return s.sprintRepo.DeleteSprint(ctx, sprintID)
+}
+
+func (s *SprintServiceImpl) GetSprint(ctx context.Context, customerID, projectID, sprintID string) (*models.Sprint, error) {
+	sp, err := s.sprintRepo.GetSprintByID(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	if sp == nil || sp.ProjectID != projectID || sp.CustomerID != customerID {
+		return nil, models.ErrSprintNotFound
+	}
+	return sp, nil
+}
+
+func (s *SprintServiceImpl) ListSprints(ctx context.Context, customerID, projectID string) ([]models.Sprint, error) {
+	if err := s.ensureProject(ctx, customerID, projectID); err != nil {
+		return nil, err
+	}
+	return s.sprintRepo.ListSprintsByProject(ctx, projectID)
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   LIFECYCLE
+// ─────────────────────────────────────────────────────────────
+//
+
+// StartSprint activates a planned sprint. Missing dates default to now and
+// a two-week length.
+func (s *SprintServiceImpl) StartSprint(ctx context.Context, customerID, projectID, sprintID, userID string) (*models.Sprint, error) {
+	sp, err := s.GetSprint(ctx, customerID, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	if sp.StartDate != nil {
+		start = *sp.StartDate
+	}
+	end := start.Add(models.DefaultSprintLength)
+	if sp.EndDate != nil {
+		end = *sp.EndDate
+	}
+
+	if err := s.sprintRepo.StartSprint(ctx, sprintID, start, end); err != nil {
+		return nil, err
+	}
+
+	sp.State = models.SprintActive
+	sp.StartDate = &start
+	sp.EndDate = &end
+
+	s.board.send(projectID, map[string]any{
+		"type":      "sprint_started",
+		"projectID": projectID,
+		"sprint":    sp,
+	})
+
+	return sp, nil
+}
+
+// CompleteSprint closes the active sprint and rolls its unfinished issues
+// over. The report is also stored on the sprint.
+func (s *SprintServiceImpl) CompleteSprint(ctx context.Context, customerID, projectID, sprintID string, req models.CompleteSprintRequest, userID string) (*models.SprintReport, error) {
+	if _, err := s.GetSprint(ctx, customerID, projectID, sprintID); err != nil {
+		return nil, err
+	}
+
+	next := req.NextSprintID
+	if req.ToBacklog {
+		next = nil
+	} else if next == nil {
+		// Planned sprints are listed by start date, earliest first.
+		sprints, err := s.sprintRepo.ListSprintsByProject(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		for _, sp := range sprints {
+			if sp.State == models.SprintPlanned {
+				id := sp.ID
+				next = &id
+				break
+			}
+		}
+	}
+
+	report, err := s.sprintRepo.CompleteSprint(ctx, sprintID, next)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range report.Incomplete {
+		_ = s.activity.Log(ctx, i.ID, &userID, models.ActivitySprintChanged, map[string]interface{}{
+			"old":    sprintID,
+			"new":    next,
+			"reason": "sprint_completed",
+		})
+	}
+
+	s.board.send(projectID, map[string]any{
+		"type":      "sprint_completed",
+		"projectID": projectID,
+		"report":    report,
+	})
+
+	return report, nil
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   BACKLOG / SPRINT CONTENT
+// ─────────────────────────────────────────────────────────────
+//
+
+func (s *SprintServiceImpl) ListSprintIssues(ctx context.Context, customerID, projectID string, sprintID *string) ([]models.Issue, error) {
+	if err := s.ensureList(ctx, customerID, projectID, sprintID, false); err != nil {
+		return nil, err
+	}
+	return s.sprintRepo.ListIssues(ctx, projectID, sprintID)
+}
+
+func (s *SprintServiceImpl) MoveIssues(ctx context.Context, customerID, projectID string, sprintID *string, issueIDs []string, userID string) ([]models.SprintMove, error) {
+	if len(issueIDs) == 0 {
+		return nil, errors.New("issue_ids is required")
+	}
+	if err := s.ensureList(ctx, customerID, projectID, sprintID, true); err != nil {
+		return nil, err
+	}
+
+	moves, err := s.sprintRepo.MoveIssues(ctx, projectID, sprintID, issueIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range moves {
+		_ = s.activity.Log(ctx, m.IssueID, &userID, models.ActivitySprintChanged, map[string]interface{}{
+			"old": m.From,
+			"new": m.To,
+		})
+	}
+
+	if len(moves) > 0 {
+		s.board.send(projectID, map[string]any{
+			"type":      "issues_sprint_changed",
+			"projectID": projectID,
+			"moves":     moves,
+		})
+	}
+
+	return moves, nil
+}
+
+// RankIssues reorders a sprint (or the backlog). issueIDs must all belong
+// to that list; issues left out keep their rank.
+func (s *SprintServiceImpl) RankIssues(ctx context.Context, customerID, projectID string, sprintID *string, issueIDs []string) error {
+	if len(issueIDs) == 0 {
+		return errors.New("issue_ids is required")
+	}
+
+	current, err := s.ListSprintIssues(ctx, customerID, projectID, sprintID)
+	if err != nil {
+		return err
+	}
+	inList := make(map[string]bool, len(current))
+	for _, i := range current {
+		inList[i.ID] = true
+	}
+	seen := map[string]bool{}
+	for _, id := range issueIDs {
+		if !inList[id] {
+			return errors.New("issue is not in this list: " + id)
+		}
+		if seen[id] {
+			return errors.New("duplicate issue id: " + id)
+		}
+		seen[id] = true
+	}
+
+	return s.sprintRepo.RankIssues(ctx, projectID, issueIDs)
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   HELPERS
+// ─────────────────────────────────────────────────────────────
+//
+
+func (s *SprintServiceImpl) ensureProject(ctx context.Context, customerID, projectID string) error {
+	pr, err := s.projectRepo.GetByID(ctx, projectID, customerID)
+	if err != nil || pr == nil {
+		return errors.New("project not found")
+	}
+	return nil
+}
+
+// ensureList checks the project, and the sprint when sprintID is set.
+// With writable set, closed sprints are rejected.
+func (s *SprintServiceImpl) ensureList(ctx context.Context, customerID, projectID string, sprintID *string, writable bool) error {
+	if sprintID == nil {
+		return s.ensureProject(ctx, customerID, projectID)
+	}
+	sp, err := s.GetSprint(ctx, customerID, projectID, *sprintID)
+	if err != nil {
+		return err
+	}
+	if writable && sp.State == models.SprintClosed {
+		return errors.New("sprint is closed")
+	}
+	return nil
+}
+
+func validateSprint(sp *models.Sprint) error {
+	sp.Name = strings.TrimSpace(sp.Name)
+	if sp.Name == "" {
+		return errors.New("name is required")
+	}
+	if sp.StartDate != nil && sp.EndDate != nil && !sp.EndDate.After(*sp.StartDate) {
+		return errors.New("end_date must be after start_date")
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	repo "bugforge-backend/internal/repository/interfaces"
+	"context"
+	"log"
+	"time"
+)
+
+const (
+	DefaultTrashRetention = 30 * 24 * time.Hour
+	trashPurgeInterval    = time.Hour
+)
+
+// TrashPurger permanently removes issues, comments and projects that have
+// been in the trash for longer than the retention period.
+type TrashPurger struct {
+	issueRepo   repo.IssueRepository
+	projectRepo repo.ProjectRepository
+	retention   time.Duration
+}
+
+func NewTrashPurger(issueRepo repo.IssueRepository, projectRepo repo.ProjectRepository, retention time.Duration) *TrashPurger {
+	if retention <= 0 {
+		retention = DefaultTrashRetention
+	}
+	return &TrashPurger{
+		issueRepo:   issueRepo,
+		projectRepo: projectRepo,
+		retention:   retention,
+	}
+}
+
+// Run purges once immediately and then every hour until ctx is cancelled.
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		p.PurgeOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *TrashPurger) PurgeOnce(ctx context.Context) {
+	before := time.Now().Add(-p.retention)
+
+	// Issues first: a trashed project's issues share its deleted_at.
+	issues, err := p.issueRepo.PurgeDeleted(ctx, before)
+	if err != nil {
+		log.Println("trash purge (issues):", err)
+		return
+	}
+
+	projects, err := p.projectRepo.PurgeDeleted(ctx, before)
+	if err != nil {
+		log.Println("trash purge (projects):", err)
+		return
+	}
+
+	if issues+projects > 0 {
+		log.Printf("trash purge: removed %d issues/comments and %d projects", issues, projects)
+	}
+}
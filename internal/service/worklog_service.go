@@ -0,0 +1,238 @@
+package service
+
+import (
+	"bugforge-backend/internal/models"
+	repo "bugforge-backend/internal/repository/interfaces"
+	service "bugforge-backend/internal/service/interfaces"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	worklogDateLayout = "2006-01-02"
+	// maxTimesheetDays bounds a single timesheet report.
+	maxTimesheetDays = 366
+)
+
+type WorklogServiceImpl struct {
+	worklogRepo repo.WorklogRepository
+	issueRepo   repo.IssueRepository
+	projectRepo repo.ProjectRepository
+	userRepo    repo.UserRepository
+	activity    service.ActivityService
+}
+
+func NewWorklogService(
+	worklogRepo repo.WorklogRepository,
+	issueRepo repo.IssueRepository,
+	projectRepo repo.ProjectRepository,
+	userRepo repo.UserRepository,
+	activitySvc service.ActivityService,
+) service.WorklogService {
+	return &WorklogServiceImpl{
+		worklogRepo: worklogRepo,
+		issueRepo:   issueRepo,
+		projectRepo: projectRepo,
+		userRepo:    userRepo,
+		activity:    activitySvc,
+	}
+}
+
+func (s *WorklogServiceImpl) LogWork(ctx context.Context, customerID, issueID string, w *models.Worklog, userID string) (*models.Worklog, error) {
+	if err := s.ensureIssue(ctx, customerID, issueID); err != nil {
+		return nil, err
+	}
+	if err := normalizeWorklog(w); err != nil {
+		return nil, err
+	}
+
+	w.ID = uuid.NewString()
+	w.IssueID = issueID
+	w.UserID = userID
+
+	if err := s.worklogRepo.CreateWorklog(ctx, w); err != nil {
+		return nil, err
+	}
+
+	_ = s.activity.Log(ctx, issueID, &userID, models.ActivityWorkLogged, map[string]interface{}{
+		"worklog_id": w.ID,
+		"minutes":    w.Minutes,
+		"work_date":  w.WorkDate,
+	})
+	return w, nil
+}
+
+// UpdateWorklog replaces duration, date and comment. Only the author may
+// edit a worklog.
+func (s *WorklogServiceImpl) UpdateWorklog(ctx context.Context, customerID, issueID, worklogID string, w *models.Worklog, userID string) (*models.Worklog, error) {
+	cur, err := s.getOwnWorklog(ctx, customerID, issueID, worklogID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := normalizeWorklog(w); err != nil {
+		return nil, err
+	}
+
+	oldMinutes, oldDate := cur.Minutes, cur.WorkDate
+	cur.Minutes = w.Minutes
+	cur.WorkDate = w.WorkDate
+	cur.Comment = w.Comment
+
+	if err := s.worklogRepo.UpdateWorklog(ctx, cur); err != nil {
+		return nil, err
+	}
+
+	_ = s.activity.Log(ctx, issueID, &userID, models.ActivityWorklogUpdated, map[string]interface{}{
+		"worklog_id": cur.ID,
+		"old":        map[string]interface{}{"minutes": oldMinutes, "work_date": oldDate},
+		"new":        map[string]interface{}{"minutes": cur.Minutes, "work_date": cur.WorkDate},
+	})
+	return cur, nil
+}
+
+func (s *WorklogServiceImpl) DeleteWorklog(ctx context.Context, customerID, issueID, worklogID, userID string) error {
+	cur, err := s.getOwnWorklog(ctx, customerID, issueID, worklogID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.worklogRepo.DeleteWorklog(ctx, worklogID); err != nil {
+		return err
+	}
+
+	_ = s.activity.Log(ctx, issueID, &userID, models.ActivityWorklogDeleted, map[string]interface{}{
+		"worklog_id": cur.ID,
+		"minutes":    cur.Minutes,
+		"work_date":  cur.WorkDate,
+	})
+	return nil
+}
+
+func (s *WorklogServiceImpl) ListWorklogs(ctx context.Context, customerID, issueID string) ([]models.Worklog, error) {
+	if err := s.ensureIssue(ctx, customerID, issueID); err != nil {
+		return nil, err
+	}
+	return s.worklogRepo.ListWorklogsByIssue(ctx, issueID)
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   TIMESHEET
+// ─────────────────────────────────────────────────────────────
+//
+
+// GetTimesheet defaults to the last seven days ending today.
+func (s *WorklogServiceImpl) GetTimesheet(ctx context.Context, customerID, userID, from, to, projectID string) (*models.Timesheet, error) {
+	u, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil || u == nil || u.CustomerID != customerID {
+		return nil, errors.New("user not found")
+	}
+
+	end := time.Now().UTC()
+	if to != "" {
+		if end, err = time.Parse(worklogDateLayout, to); err != nil {
+			return nil, errors.New("invalid to date, expected YYYY-MM-DD")
+		}
+	}
+	start := end.AddDate(0, 0, -6)
+	if from != "" {
+		if start, err = time.Parse(worklogDateLayout, from); err != nil {
+			return nil, errors.New("invalid from date, expected YYYY-MM-DD")
+		}
+	}
+	if start.After(end) {
+		return nil, errors.New("from must not be after to")
+	}
+	if end.Sub(start) > maxTimesheetDays*24*time.Hour {
+		return nil, errors.New("date range is too long")
+	}
+
+	var project *string
+	if projectID != "" {
+		pr, err := s.projectRepo.GetByID(ctx, projectID, customerID)
+		if err != nil || pr == nil {
+			return nil, errors.New("project not found")
+		}
+		project = &projectID
+	}
+
+	out := &models.Timesheet{
+		UserID: userID,
+		From:   start.Format(worklogDateLayout),
+		To:     end.Format(worklogDateLayout),
+		Days:   []models.TimesheetDay{},
+	}
+
+	entries, err := s.worklogRepo.ListTimesheet(ctx, customerID, userID, out.From, out.To, project)
+	if err != nil {
+		return nil, err
+	}
+
+	// Entries arrive ordered by date
+	for _, e := range entries {
+		if n := len(out.Days); n == 0 || out.Days[n-1].Date != e.Date {
+			out.Days = append(out.Days, models.TimesheetDay{Date: e.Date, Entries: []models.TimesheetEntry{}})
+		}
+		day := &out.Days[len(out.Days)-1]
+		day.Entries = append(day.Entries, e)
+		day.TotalMinutes += e.Minutes
+		out.TotalMinutes += e.Minutes
+	}
+
+	return out, nil
+}
+
+//
+// ─────────────────────────────────────────────────────────────
+//   HELPERS
+// ─────────────────────────────────────────────────────────────
+//
+
+// normalizeWorklog validates the duration and date; a missing date means
+// today.
+func normalizeWorklog(w *models.Worklog) error {
+	if w.Minutes <= 0 {
+		return errors.New("minutes must be positive")
+	}
+	w.WorkDate = strings.TrimSpace(w.WorkDate)
+	if w.WorkDate == "" {
+		w.WorkDate = time.Now().UTC().Format(worklogDateLayout)
+	} else if _, err := time.Parse(worklogDateLayout, w.WorkDate); err != nil {
+		return errors.New("invalid work_date, expected YYYY-MM-DD")
+	}
+	return nil
+}
+
+func (s *WorklogServiceImpl) getOwnWorklog(ctx context.Context, customerID, issueID, worklogID, userID string) (*models.Worklog, error) {
+	if err := s.ensureIssue(ctx, customerID, issueID); err != nil {
+		return nil, err
+	}
+
+	w, err := s.worklogRepo.GetWorklogByID(ctx, worklogID)
+	if err != nil {
+		return nil, err
+	}
+	if w == nil || w.IssueID != issueID {
+		return nil, models.ErrWorklogNotFound
+	}
+	if w.UserID != userID {
+		return nil, errors.New("cannot edit others' worklogs")
+	}
+	return w, nil
+}
+
+func (s *WorklogServiceImpl) ensureIssue(ctx context.Context, customerID, issueID string) error {
+	iss, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil || iss == nil {
+		return errors.New("issue not found")
+	}
+	pr, err := s.projectRepo.GetByID(ctx, iss.ProjectID, customerID)
+	if err != nil || pr == nil {
+		return errors.New("tenant mismatch")
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ type Client struct {
     send      chan []byte
     userID    string
     projectID string
+    boardID   string
 }
 
 func (c *Client) WritePump() {
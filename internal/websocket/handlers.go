@@ -9,6 +9,10 @@ import (
 func (h *Hub) WSHandler(conn *websocket.Conn) {
 	projectID := conn.Params("projectID")
 
+	// Set by the route: the board in the path, or the project's default
+	// board on the legacy project route
+	boardID, _ := conn.Locals("board_id").(string)
+
 	userID, ok := conn.Locals("user_id").(string)
 	if !ok {
 		conn.WriteMessage(websocket.TextMessage, []byte(`{"error":"unauthorized"}`))
@@ -16,13 +20,14 @@ func (h *Hub) WSHandler(conn *websocket.Conn) {
 		return
 	}
 
-	room := h.GetRoom(projectID)
+	room := h.GetRoom(projectID, boardID)
 
 	client := &Client{
 		conn:      conn,
 		send:      make(chan []byte, 256),
 		userID:    userID,
 		projectID: projectID,
+		boardID:   boardID,
 	}
 
 	room.register <- client
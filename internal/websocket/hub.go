@@ -4,16 +4,26 @@ import "sync"
 
 type Hub struct {
     mu    sync.RWMutex
-    rooms map[string]*Room // projectID → Room
+    rooms map[string]*Room // boardID → Room
+
+    // Portfolio rooms receive the events of the project rooms they watch
+    portfolios map[string]*Room          // portfolioID/userID → Room
+    watchers   map[string]map[*Room]bool // projectID → portfolio rooms
 }
 
 func NewHub() *Hub {
-    return &Hub{rooms: make(map[string]*Room)}
+    return &Hub{
+        rooms:      make(map[string]*Room),
+        portfolios: make(map[string]*Room),
+        watchers:   make(map[string]map[*Room]bool),
+    }
 }
 
-func (h *Hub) GetRoom(projectID string) *Room {
+// GetRoom returns the room of a kanban board. Each board of a project has
+// a room of its own.
+func (h *Hub) GetRoom(projectID, boardID string) *Room {
     h.mu.RLock()
-    r, ok := h.rooms[projectID]
+    r, ok := h.rooms[boardID]
     h.mu.RUnlock()
 
     if ok {
@@ -24,12 +34,31 @@ func (h *Hub) GetRoom(projectID string) *Room {
     defer h.mu.Unlock()
 
     // double-check
-    if r, ok = h.rooms[projectID]; ok {
+    if r, ok = h.rooms[boardID]; ok {
         return r
     }
 
-    r = NewRoom(projectID)
-    h.rooms[projectID] = r
+    r = NewRoom(projectID, boardID)
+    r.hub = h
+    h.rooms[boardID] = r
     go r.Run()
     return r
 }
+
+// BroadcastProject sends msg to every open board room of a project, for
+// changes that are not tied to one board.
+func (h *Hub) BroadcastProject(projectID string, msg []byte) {
+    h.mu.RLock()
+    var rooms []*Room
+    for _, r := range h.rooms {
+        if r.projectID == projectID {
+            rooms = append(rooms, r)
+        }
+    }
+    h.mu.RUnlock()
+
+    for _, r := range rooms {
+        r.broadcast <- msg
+    }
+    h.forward(projectID, msg)
+}
@@ -0,0 +1,152 @@
+package websocket
+
+import (
+    "encoding/json"
+
+    "github.com/gofiber/websocket/v2"
+)
+
+// GetPortfolioRoom adds client to the room of one viewer of a portfolio
+// board, creating the room if needed. The room receives the events of
+// projectIDs, the portfolio projects the viewer is a member of, wrapped in
+// a "project_event". Each viewer has a room of their own so that nobody
+// sees events of projects they cannot open. Clients join and leave under
+// the hub lock, so a room is never dropped while a client is joining it.
+func (h *Hub) GetPortfolioRoom(portfolioID, userID string, projectIDs []string, client *Client) *Room {
+    key := portfolioID + "/" + userID
+
+    h.mu.Lock()
+    defer h.mu.Unlock()
+
+    r, ok := h.portfolios[key]
+    if !ok {
+        r = NewRoom("", portfolioID)
+        h.portfolios[key] = r
+        go r.Run()
+    }
+
+    // The portfolio may have changed since the last connection
+    h.unwatch(r)
+    for _, projectID := range projectIDs {
+        if h.watchers[projectID] == nil {
+            h.watchers[projectID] = make(map[*Room]bool)
+        }
+        h.watchers[projectID][r] = true
+    }
+
+    r.mu.Lock()
+    r.clients[client] = true
+    r.mu.Unlock()
+    return r
+}
+
+// leavePortfolioRoom removes client from its portfolio room. The room, its
+// goroutine and its watcher entries go away with the last client.
+func (h *Hub) leavePortfolioRoom(portfolioID, userID string, r *Room, client *Client) {
+    h.mu.Lock()
+    defer h.mu.Unlock()
+
+    r.mu.Lock()
+    if _, ok := r.clients[client]; ok {
+        delete(r.clients, client)
+        close(client.send)
+    }
+    empty := len(r.clients) == 0
+    r.mu.Unlock()
+
+    if !empty {
+        return
+    }
+    h.unwatch(r)
+    delete(h.portfolios, portfolioID+"/"+userID)
+    close(r.done)
+}
+
+// unwatch drops the watcher entries of a portfolio room. The caller holds
+// h.mu.
+func (h *Hub) unwatch(r *Room) {
+    for projectID, rooms := range h.watchers {
+        delete(rooms, r)
+        if len(rooms) == 0 {
+            delete(h.watchers, projectID)
+        }
+    }
+}
+
+// deliver hands msg to a portfolio room unless the room has been dropped
+// meanwhile.
+func deliver(r *Room, msg []byte) {
+    select {
+    case r.broadcast <- msg:
+    case <-r.done:
+    }
+}
+
+// BroadcastPortfolio sends msg to every viewer of a portfolio board.
+func (h *Hub) BroadcastPortfolio(portfolioID string, msg []byte) {
+    h.mu.RLock()
+    var rooms []*Room
+    for _, r := range h.portfolios {
+        if r.boardID == portfolioID {
+            rooms = append(rooms, r)
+        }
+    }
+    h.mu.RUnlock()
+
+    for _, r := range rooms {
+        deliver(r, msg)
+    }
+}
+
+// forward passes an event of a project room on to the portfolio rooms
+// watching the project.
+func (h *Hub) forward(projectID string, msg []byte) {
+    h.mu.RLock()
+    var rooms []*Room
+    for r := range h.watchers[projectID] {
+        rooms = append(rooms, r)
+    }
+    h.mu.RUnlock()
+    if len(rooms) == 0 {
+        return
+    }
+
+    b, _ := json.Marshal(map[string]any{
+        "type":       "project_event",
+        "project_id": projectID,
+        "event":      json.RawMessage(msg),
+    })
+    for _, r := range rooms {
+        deliver(r, b)
+    }
+}
+
+// PortfolioWSHandler serves a portfolio board. The route resolves the
+// portfolio and the viewer's projects into the locals; clients only listen.
+func (h *Hub) PortfolioWSHandler(conn *websocket.Conn) {
+    userID, ok := conn.Locals("user_id").(string)
+    if !ok {
+        conn.WriteMessage(websocket.TextMessage, []byte(`{"error":"unauthorized"}`))
+        conn.Close()
+        return
+    }
+    portfolioID, _ := conn.Locals("portfolio_id").(string)
+    projectIDs, _ := conn.Locals("portfolio_projects").([]string)
+
+    client := &Client{
+        conn:    conn,
+        send:    make(chan []byte, 256),
+        userID:  userID,
+        boardID: portfolioID,
+    }
+
+    room := h.GetPortfolioRoom(portfolioID, userID, projectIDs, client)
+    go client.WritePump()
+
+    for {
+        if _, _, err := conn.ReadMessage(); err != nil {
+            h.leavePortfolioRoom(portfolioID, userID, room, client)
+            break
+        }
+    }
+}
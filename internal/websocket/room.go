@@ -7,6 +7,8 @@ import (
 
 type Room struct {
     projectID  string
+    boardID    string // the portfolio id for portfolio rooms
+    hub        *Hub   // set on project rooms, to forward to portfolios
     clients    map[*Client]bool
     broadcast  chan []byte
     register   chan *Client
@@ -15,9 +17,10 @@ type Room struct {
     mu         sync.RWMutex
 }
 
-func NewRoom(projectID string) *Room {
+func NewRoom(projectID, boardID string) *Room {
     return &Room{
         projectID:  projectID,
+        boardID:    boardID,
         clients:    make(map[*Client]bool),
         broadcast:  make(chan []byte, 256),
         register:   make(chan *Client),
@@ -68,5 +71,8 @@ func (r *Room) Run() {
 
 func (r *Room) Broadcast(msg []byte) {
     r.broadcast <- msg
+    if r.hub != nil {
+        r.hub.forward(r.projectID, msg)
+    }
 }
 
@@ -0,0 +1,38 @@
+-- Full-text search over issues, comments and attachments, plus trigram
+-- indexes used for the typo-tolerant fallback and the global /search endpoint.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Issues: title is weighted above description so title hits rank first.
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_issues_search_vector ON issues USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_issues_title_trgm ON issues USING GIN (title gin_trgm_ops);
+
+-- Comments: strip the rich-text markup before indexing.
+ALTER TABLE issue_comments
+    ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        to_tsvector('english', regexp_replace(coalesce(body, ''), '<[^>]+>', ' ', 'g'))
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_issue_comments_search_vector ON issue_comments USING GIN (search_vector);
+
+-- Attachments: split filenames on separators so "crash_log.txt" matches "crash".
+ALTER TABLE issue_attachments
+    ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        to_tsvector('simple', regexp_replace(coalesce(filename, ''), '[._\-]+', ' ', 'g'))
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_issue_attachments_search_vector ON issue_attachments USING GIN (search_vector);
+
+-- Projects and users are matched by trigram similarity only.
+CREATE INDEX IF NOT EXISTS idx_projects_name_trgm ON projects USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_name_trgm ON users USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_users_username_trgm ON users USING GIN (username gin_trgm_ops);
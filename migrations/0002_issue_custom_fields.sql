@@ -0,0 +1,5 @@
+-- Free-form per-issue custom fields (e.g. {"severity": "S2", "customer": "Axis"}).
+-- Issue lists can sort on any key via sort=custom.<key>.
+
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}'::jsonb;
@@ -0,0 +1,36 @@
+-- Soft deletion for projects, issues and comments.
+--
+-- Deleting a parent stamps its live children with the same deleted_at, so a
+-- restore can bring back exactly the rows that went to the trash together
+-- (and leave alone children that had been deleted on their own earlier).
+-- Rows are hard-deleted by the background purger once they are older than
+-- TRASH_RETENTION_DAYS.
+
+ALTER TABLE projects
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS deleted_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS deleted_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE issue_comments
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS deleted_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_projects_deleted_at ON projects (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_issues_deleted_at ON issues (project_id, deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_issue_comments_deleted_at ON issue_comments (issue_id, deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Slugs only need to be unique among live projects.
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM pg_constraint WHERE conname = 'projects_customer_id_slug_key'
+    ) THEN
+        ALTER TABLE projects DROP CONSTRAINT projects_customer_id_slug_key;
+    END IF;
+END $$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_customer_slug_live
+    ON projects (customer_id, slug) WHERE deleted_at IS NULL;
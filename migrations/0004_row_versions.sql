@@ -0,0 +1,12 @@
+-- Row versions for optimistic concurrency control. Every write bumps the
+-- version; PATCH requests carrying If-Match / expected_version only apply
+-- when it still matches, otherwise the API answers 409 with the current row.
+
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE issue_comments
+    ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE checklist_items
+    ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,10 @@
+-- Labels attached to issues (labels themselves are project-scoped).
+
+CREATE TABLE IF NOT EXISTS issue_labels (
+    issue_id   UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    label_id   UUID NOT NULL REFERENCES labels(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (issue_id, label_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_issue_labels_label ON issue_labels (label_id);
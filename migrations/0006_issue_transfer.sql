@@ -0,0 +1,5 @@
+-- Issues moved to another project remember where they came from.
+-- Clones point back to their source through issue_relations (cloned_from).
+
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS moved_from_project_id UUID NULL REFERENCES projects(id) ON DELETE SET NULL;
@@ -0,0 +1,24 @@
+-- Per-project issue templates (bug report, feature request, ...).
+-- Checklists and subtasks are stored inline; they are copied onto the issue
+-- when the template is used.
+
+CREATE TABLE IF NOT EXISTS issue_templates (
+    id            UUID PRIMARY KEY,
+    customer_id   UUID NOT NULL,
+    project_id    UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name          TEXT NOT NULL,
+    title_prefix  TEXT NOT NULL DEFAULT '',
+    description   TEXT NOT NULL DEFAULT '',
+    priority      TEXT NULL,
+    assigned_to   UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    label_ids     UUID[] NOT NULL DEFAULT '{}',
+    checklists    JSONB NOT NULL DEFAULT '[]',
+    subtasks      JSONB NOT NULL DEFAULT '[]',
+    custom_fields JSONB NOT NULL DEFAULT '{}',
+    created_by    UUID NOT NULL,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (project_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_issue_templates_project ON issue_templates (project_id);
@@ -0,0 +1,30 @@
+-- Reusable checklist templates. Every edit stores a new immutable version;
+-- applying a template copies one version onto the issue, so later edits
+-- never change checklists that were already applied.
+
+CREATE TABLE IF NOT EXISTS checklist_templates (
+    id              UUID PRIMARY KEY,
+    customer_id     UUID NOT NULL,
+    project_id      UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name            TEXT NOT NULL,
+    current_version INT NOT NULL DEFAULT 1,
+    created_by      UUID NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (project_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS checklist_template_versions (
+    template_id UUID NOT NULL REFERENCES checklist_templates(id) ON DELETE CASCADE,
+    version     INT NOT NULL,
+    title       TEXT NOT NULL,
+    items       JSONB NOT NULL DEFAULT '[]',
+    created_by  UUID NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (template_id, version)
+);
+
+-- Which template version a checklist was created from.
+ALTER TABLE checklists
+    ADD COLUMN IF NOT EXISTS template_id      UUID NULL REFERENCES checklist_templates(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS template_version INT NULL;
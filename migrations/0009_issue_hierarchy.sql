@@ -0,0 +1,12 @@
+-- Issue hierarchy: epics contain stories, which contain tasks and bugs.
+-- Parents must live in the same project; the service rejects cycles.
+-- Story points feed the epic rollup.
+
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS issue_type      TEXT NOT NULL DEFAULT 'task'
+        CHECK (issue_type IN ('epic', 'story', 'task', 'bug')),
+    ADD COLUMN IF NOT EXISTS parent_issue_id UUID NULL REFERENCES issues(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS story_points    INT NULL CHECK (story_points >= 0);
+
+CREATE INDEX IF NOT EXISTS issues_parent_issue_id_idx
+    ON issues (parent_issue_id) WHERE parent_issue_id IS NOT NULL;
@@ -0,0 +1,31 @@
+-- Sprints (iterations). A project has at most one active sprint; issues
+-- without a sprint make up the backlog. backlog_order ranks issues inside
+-- the backlog and inside each sprint.
+
+CREATE TABLE IF NOT EXISTS sprints (
+    id           UUID PRIMARY KEY,
+    customer_id  UUID NOT NULL,
+    project_id   UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name         TEXT NOT NULL,
+    goal         TEXT NOT NULL DEFAULT '',
+    start_date   TIMESTAMPTZ NULL,
+    end_date     TIMESTAMPTZ NULL,
+    state        TEXT NOT NULL DEFAULT 'planned'
+        CHECK (state IN ('planned', 'active', 'closed')),
+    -- Snapshot written when the sprint is completed.
+    report       JSONB NULL,
+    created_by   UUID NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS sprints_project_id_idx ON sprints (project_id);
+CREATE UNIQUE INDEX IF NOT EXISTS sprints_one_active_idx
+    ON sprints (project_id) WHERE state = 'active';
+
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS sprint_id     UUID NULL REFERENCES sprints(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS backlog_order INT NULL;
+
+CREATE INDEX IF NOT EXISTS issues_sprint_id_idx ON issues (sprint_id) WHERE sprint_id IS NOT NULL;
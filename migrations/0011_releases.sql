@@ -0,0 +1,21 @@
+-- Project releases (fix versions). An issue ships in at most one release.
+
+CREATE TABLE IF NOT EXISTS releases (
+    id          UUID PRIMARY KEY,
+    customer_id UUID NOT NULL,
+    project_id  UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name        TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    target_date TIMESTAMPTZ NULL,
+    released    BOOLEAN NOT NULL DEFAULT FALSE,
+    released_at TIMESTAMPTZ NULL,
+    created_by  UUID NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (project_id, name)
+);
+
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS fix_version_id UUID NULL REFERENCES releases(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS issues_fix_version_id_idx ON issues (fix_version_id) WHERE fix_version_id IS NOT NULL;
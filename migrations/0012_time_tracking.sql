@@ -0,0 +1,19 @@
+-- Time tracking: estimates on issues (in minutes) and worklog entries.
+
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS original_estimate_minutes  INT NULL CHECK (original_estimate_minutes >= 0),
+    ADD COLUMN IF NOT EXISTS remaining_estimate_minutes INT NULL CHECK (remaining_estimate_minutes >= 0);
+
+CREATE TABLE IF NOT EXISTS worklogs (
+    id         UUID PRIMARY KEY,
+    issue_id   UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    user_id    UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    minutes    INT NOT NULL CHECK (minutes > 0),
+    work_date  DATE NOT NULL,
+    comment    TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS worklogs_issue_id_idx ON worklogs (issue_id);
+CREATE INDEX IF NOT EXISTS worklogs_user_date_idx ON worklogs (user_id, work_date);
@@ -0,0 +1,12 @@
+-- Column-move history for flow analytics (cumulative flow, cycle time).
+-- One row per card moved between kanban columns.
+
+CREATE TABLE IF NOT EXISTS issue_column_moves (
+    id             UUID PRIMARY KEY,
+    issue_id       UUID NOT NULL REFERENCES issues(id) ON DELETE CASCADE,
+    from_column_id UUID NULL,
+    to_column_id   UUID NOT NULL,
+    moved_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS issue_column_moves_issue_idx ON issue_column_moves (issue_id, moved_at);
@@ -0,0 +1,9 @@
+-- Project-level activity (kanban column lifecycle) shares the issue
+-- activity log: such entries carry project_id and no issue_id.
+
+ALTER TABLE issue_activity_logs
+    ALTER COLUMN issue_id DROP NOT NULL,
+    ADD COLUMN IF NOT EXISTS project_id UUID NULL REFERENCES projects(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS issue_activity_logs_project_id_idx
+    ON issue_activity_logs (project_id) WHERE project_id IS NOT NULL;
@@ -0,0 +1,7 @@
+-- Work-in-progress limits per kanban column. hard rejects cards beyond
+-- the limit, soft lets them in and warns the board.
+
+ALTER TABLE kanban_columns
+    ADD COLUMN IF NOT EXISTS wip_limit INT NULL CHECK (wip_limit > 0),
+    ADD COLUMN IF NOT EXISTS wip_mode  TEXT NOT NULL DEFAULT 'soft'
+        CHECK (wip_mode IN ('soft', 'hard'));
@@ -0,0 +1,45 @@
+-- Kanban cards and columns are ordered by a lexicographic rank instead of
+-- a dense integer order, so a move rewrites only the moved row. Ranks are
+-- base-36 digit strings compared bytewise, hence COLLATE "C". The integer
+-- "order" columns are no longer maintained; positions are derived from
+-- the ranks when the board is read.
+
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS rank TEXT COLLATE "C" NULL;
+
+ALTER TABLE kanban_columns
+    ADD COLUMN IF NOT EXISTS rank TEXT COLLATE "C" NULL;
+
+-- Seed equal-width ranks from the existing orders. Trashed cards keep a
+-- rank so that restoring them puts them back in place.
+UPDATE issues i
+SET rank = s.rank
+FROM (
+    SELECT id, lpad((ROW_NUMBER() OVER (
+        PARTITION BY column_id ORDER BY "order" ASC NULLS LAST, created_at, id
+    ) * 1000)::text, 10, '0') AS rank
+    FROM issues
+    WHERE column_id IS NOT NULL
+) s
+WHERE i.id = s.id AND i.rank IS NULL;
+
+UPDATE kanban_columns c
+SET rank = s.rank
+FROM (
+    SELECT id, lpad((ROW_NUMBER() OVER (
+        PARTITION BY project_id ORDER BY "order" ASC NULLS LAST, id
+    ) * 1000)::text, 10, '0') AS rank
+    FROM kanban_columns
+) s
+WHERE c.id = s.id AND c.rank IS NULL;
+
+ALTER TABLE kanban_columns ALTER COLUMN rank SET NOT NULL;
+
+ALTER TABLE issues ALTER COLUMN "order" DROP NOT NULL;
+ALTER TABLE kanban_columns ALTER COLUMN "order" DROP NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_issues_column_rank
+    ON issues (column_id, rank, id);
+
+CREATE INDEX IF NOT EXISTS idx_kanban_columns_project_rank
+    ON kanban_columns (project_id, rank, id);
@@ -0,0 +1,15 @@
+-- Saved quick filters for the kanban board. filter holds a BoardFilter
+-- (assignees, labels, priorities, text, due range, ...).
+
+CREATE TABLE IF NOT EXISTS board_quick_filters (
+    id         UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name       TEXT NOT NULL,
+    filter     JSONB NOT NULL DEFAULT '{}',
+    created_by UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (project_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_quick_filters_project ON board_quick_filters (project_id);
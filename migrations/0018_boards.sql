@@ -0,0 +1,42 @@
+-- Several kanban boards per project, each with its own columns. A card
+-- belongs to the board of its column; the board filter (a BoardFilter,
+-- e.g. {"label_ids": [...]}) further decides which of those cards appear.
+-- Every project keeps one default board that the project-level kanban
+-- routes use.
+
+CREATE TABLE IF NOT EXISTS boards (
+    id         UUID PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name       TEXT NOT NULL,
+    filter     JSONB NOT NULL DEFAULT '{}',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by UUID NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (project_id, name)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_boards_project_default
+    ON boards (project_id) WHERE is_default;
+
+-- The existing columns become the default board of their project.
+INSERT INTO boards (id, project_id, name, is_default)
+SELECT gen_random_uuid(), p.id, 'Board', TRUE
+FROM projects p
+WHERE EXISTS (SELECT 1 FROM kanban_columns c WHERE c.project_id = p.id)
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE kanban_columns
+    ADD COLUMN IF NOT EXISTS board_id UUID NULL REFERENCES boards(id) ON DELETE CASCADE;
+
+UPDATE kanban_columns c
+SET board_id = b.id
+FROM boards b
+WHERE b.project_id = c.project_id AND b.is_default AND c.board_id IS NULL;
+
+ALTER TABLE kanban_columns ALTER COLUMN board_id SET NOT NULL;
+
+-- Column ranks are now per board.
+DROP INDEX IF EXISTS idx_kanban_columns_project_rank;
+CREATE INDEX IF NOT EXISTS idx_kanban_columns_board_rank
+    ON kanban_columns (board_id, rank, id);
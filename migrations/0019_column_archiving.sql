@@ -0,0 +1,6 @@
+-- Archived columns are hidden from the board without touching their
+-- cards; unarchiving puts a column back at its old rank.
+
+ALTER TABLE kanban_columns
+    ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS archived_by UUID NULL;
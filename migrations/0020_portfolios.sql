@@ -0,0 +1,41 @@
+-- Portfolio boards span several projects of a customer. Each portfolio
+-- column gathers the cards of the project columns mapped into it; a
+-- project column maps into at most one column of a portfolio.
+
+CREATE TABLE IF NOT EXISTS portfolios (
+    id          UUID PRIMARY KEY,
+    customer_id UUID NOT NULL,
+    name        TEXT NOT NULL,
+    created_by  UUID NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (customer_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS portfolio_projects (
+    portfolio_id UUID NOT NULL REFERENCES portfolios(id) ON DELETE CASCADE,
+    project_id   UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    PRIMARY KEY (portfolio_id, project_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_portfolio_projects_project ON portfolio_projects (project_id);
+
+CREATE TABLE IF NOT EXISTS portfolio_columns (
+    id           UUID PRIMARY KEY,
+    portfolio_id UUID NOT NULL REFERENCES portfolios(id) ON DELETE CASCADE,
+    name         TEXT NOT NULL,
+    rank         TEXT COLLATE "C" NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_portfolio_columns_portfolio_rank
+    ON portfolio_columns (portfolio_id, rank, id);
+
+CREATE TABLE IF NOT EXISTS portfolio_column_mappings (
+    portfolio_id        UUID NOT NULL REFERENCES portfolios(id) ON DELETE CASCADE,
+    portfolio_column_id UUID NOT NULL REFERENCES portfolio_columns(id) ON DELETE CASCADE,
+    column_id           UUID NOT NULL REFERENCES kanban_columns(id) ON DELETE CASCADE,
+    PRIMARY KEY (portfolio_id, column_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_portfolio_column_mappings_column
+    ON portfolio_column_mappings (portfolio_column_id);
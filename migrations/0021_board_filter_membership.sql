@@ -0,0 +1,7 @@
+-- A board's filter now decides which cards of the project it shows, and
+-- cards of other boards appear in the column of the same name. The default
+-- board shows every card so that none falls off all boards: it loses any
+-- filter it was given.
+
+UPDATE boards SET filter = '{}', updated_at = NOW()
+WHERE is_default AND filter <> '{}';